@@ -0,0 +1,273 @@
+package board
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/browser"
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/filter"
+	"github.com/github/gh-projects/queries"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type boardOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	field     string
+	filter    string
+}
+
+type boardConfig struct {
+	client    *api.GraphQLClient
+	opts      boardOpts
+	URLOpener func(string) error
+}
+
+type updateItemFieldMutation struct {
+	Update struct {
+		Item struct {
+			ID string
+		} `graphql:"projectV2Item"`
+	} `graphql:"updateProjectV2ItemFieldValue(input:$input)"`
+}
+
+type clearItemFieldMutation struct {
+	Clear struct {
+		Item struct {
+			ID string
+		} `graphql:"projectV2Item"`
+	} `graphql:"clearProjectV2ItemFieldValue(input:$input)"`
+}
+
+func NewCmdBoard(f *cmdutil.Factory, runF func(config boardConfig) error) *cobra.Command {
+	opts := boardOpts{}
+	boardCmd := &cobra.Command{
+		Short: "Open a project as an interactive board",
+		Use:   "board [number]",
+		Long: `
+Open a project as a full-screen board, with a column for each option of a single select field.
+Archived items are not shown.
+
+Keys:
+  left/right, h/l   select a column
+  up/down, k/j      select an item
+  </>, H/L          move the item to the previous or next column
+  enter, o          open the item in the browser
+  /                 filter the items, such as "label:bug -assignee:monalisa"
+  q, esc            quit`,
+		Example: `
+# open the current user's project 1 as a board grouped by Status
+gh projects board 1 --user "@me"
+
+# open org github's project 1 as a board grouped by Priority, showing only open bugs
+gh projects board 1 --org github --field Priority --filter "is:open label:bug"
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			if !terminal.IsTerminalOutput() {
+				return fmt.Errorf("board requires an interactive terminal, use item-list instead")
+			}
+
+			config := boardConfig{
+				client:    client,
+				opts:      opts,
+				URLOpener: browser.OpenURL,
+			}
+			return runBoard(config)
+		},
+	}
+
+	boardCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	boardCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	boardCmd.Flags().StringVar(&opts.field, "field", "Status", "Name of the single select field that defines the columns.")
+	boardCmd.Flags().StringVar(&opts.filter, "filter", "", "Only show the items matching a filter, such as \"label:bug\".")
+
+	// owner can be a user or an org
+	boardCmd.MarkFlagsMutuallyExclusive("user", "org")
+
+	return boardCmd
+}
+
+func runBoard(config boardConfig) error {
+	f, err := filter.Parse(config.opts.filter)
+	if err != nil {
+		return err
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	// no need to fetch the project if we already have the number
+	if config.opts.number == 0 {
+		project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+		if err != nil {
+			return err
+		}
+		config.opts.number = project.Number
+	}
+
+	project, err := queries.ProjectItems(config.client, owner, config.opts.number, 0)
+	if err != nil {
+		return err
+	}
+
+	field, err := columnField(project.Fields.Nodes, config.opts.field)
+	if err != nil {
+		return err
+	}
+
+	b := &board{
+		config:  config,
+		project: project,
+		field:   field,
+		filter:  f,
+		query:   config.opts.filter,
+	}
+	return b.run()
+}
+
+// columnField returns the single select field whose options define the columns of the board.
+func columnField(fields []queries.ProjectField, name string) (queries.ProjectField, error) {
+	for _, f := range fields {
+		if !strings.EqualFold(f.Name(), name) {
+			continue
+		}
+		if f.DataType() != "SINGLE_SELECT" {
+			return queries.ProjectField{}, fmt.Errorf("field %q is not a single select field", f.Name())
+		}
+		return f, nil
+	}
+	return queries.ProjectField{}, fmt.Errorf("field %q not found", name)
+}
+
+// column is a column of the board. The column of items without a value has an empty option.
+type column struct {
+	option queries.SingleSelectFieldOptions
+	items  []queries.ProjectItem
+}
+
+// name is the name of the option of the column, such as "Todo", or "No Status" for items without a value.
+func (c column) name(field queries.ProjectField) string {
+	if c.option.ID == "" {
+		return "No " + field.Name()
+	}
+	return c.option.Name
+}
+
+// title is the header of the column, such as "Todo (3)".
+func (c column) title(field queries.ProjectField) string {
+	return fmt.Sprintf("%s (%d)", c.name(field), len(c.items))
+}
+
+// buildColumns groups the items matching the filter by their option of the field, in the order of the options.
+// Archived items are left out, as they are by item-list.
+func buildColumns(items []queries.ProjectItem, field queries.ProjectField, f *filter.Filter) []column {
+	columns := []column{{}}
+	index := map[string]int{}
+	for _, o := range field.Options() {
+		index[o.ID] = len(columns)
+		columns = append(columns, column{option: o})
+	}
+
+	for _, item := range f.Items(items) {
+		if item.IsArchived {
+			continue
+		}
+		i := index[itemOptionID(item, field)]
+		columns[i].items = append(columns[i].items, item)
+	}
+	return columns
+}
+
+// itemOptionID returns the ID of the option of the field set on an item, or an empty string if it has no value.
+func itemOptionID(item queries.ProjectItem, field queries.ProjectField) string {
+	for _, v := range item.FieldValues.Nodes {
+		if v.Type != "ProjectV2ItemFieldSingleSelectValue" || v.Field().ID() != field.ID() {
+			continue
+		}
+		if v.ProjectV2ItemFieldSingleSelectValue.OptionId != "" {
+			return v.ProjectV2ItemFieldSingleSelectValue.OptionId
+		}
+		for _, o := range field.Options() {
+			if o.Name == v.ProjectV2ItemFieldSingleSelectValue.Name {
+				return o.ID
+			}
+		}
+	}
+	return ""
+}
+
+// setItemOption updates the value of the field of an item after it has been moved to another column.
+func setItemOption(item *queries.ProjectItem, field queries.ProjectField, option queries.SingleSelectFieldOptions) {
+	nodes := make([]queries.FieldValueNodes, 0, len(item.FieldValues.Nodes)+1)
+	for _, v := range item.FieldValues.Nodes {
+		if v.Field().ID() != field.ID() {
+			nodes = append(nodes, v)
+		}
+	}
+
+	if option.ID != "" {
+		v := queries.FieldValueNodes{Type: "ProjectV2ItemFieldSingleSelectValue"}
+		v.ProjectV2ItemFieldSingleSelectValue.Name = option.Name
+		v.ProjectV2ItemFieldSingleSelectValue.OptionId = option.ID
+		v.ProjectV2ItemFieldSingleSelectValue.Field = field
+		nodes = append(nodes, v)
+	}
+	item.FieldValues.Nodes = nodes
+}
+
+// moveItem sets the option of the field on an item, or clears the value if the option is empty.
+func moveItem(config boardConfig, projectID string, itemID string, field queries.ProjectField, option queries.SingleSelectFieldOptions) error {
+	if option.ID == "" {
+		query, variables := clearItemFieldArgs(projectID, itemID, field)
+		return config.client.Mutate("ClearItemFieldValue", query, variables)
+	}
+
+	query, variables := updateItemFieldArgs(projectID, itemID, field, option)
+	return config.client.Mutate("UpdateItemFieldValue", query, variables)
+}
+
+func updateItemFieldArgs(projectID string, itemID string, field queries.ProjectField, option queries.SingleSelectFieldOptions) (*updateItemFieldMutation, map[string]interface{}) {
+	return &updateItemFieldMutation{}, map[string]interface{}{
+		"input": githubv4.UpdateProjectV2ItemFieldValueInput{
+			ProjectID: githubv4.ID(projectID),
+			ItemID:    githubv4.ID(itemID),
+			FieldID:   githubv4.ID(field.ID()),
+			Value: githubv4.ProjectV2FieldValue{
+				SingleSelectOptionID: githubv4.NewString(githubv4.String(option.ID)),
+			},
+		},
+	}
+}
+
+func clearItemFieldArgs(projectID string, itemID string, field queries.ProjectField) (*clearItemFieldMutation, map[string]interface{}) {
+	return &clearItemFieldMutation{}, map[string]interface{}{
+		"input": githubv4.ClearProjectV2ItemFieldValueInput{
+			ProjectID: githubv4.ID(projectID),
+			ItemID:    githubv4.ID(itemID),
+			FieldID:   githubv4.ID(field.ID()),
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/github/gh-projects/filter"
+	"github.com/github/gh-projects/queries"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func statusField() queries.ProjectField {
+	field := queries.ProjectField{TypeName: "ProjectV2SingleSelectField"}
+	field.SingleSelectField.ID = "status ID"
+	field.SingleSelectField.Name = "Status"
+	field.SingleSelectField.DataType = "SINGLE_SELECT"
+	field.SingleSelectField.Options = []queries.SingleSelectFieldOptions{
+		{ID: "todo ID", Name: "Todo"},
+		{ID: "done ID", Name: "Done"},
+	}
+	return field
+}
+
+func item(id string, title string, option *queries.SingleSelectFieldOptions) queries.ProjectItem {
+	i := queries.ProjectItem{Id: id}
+	i.Content.TypeName = "DraftIssue"
+	i.Content.DraftIssue.Title = title
+	if option != nil {
+		setItemOption(&i, statusField(), *option)
+	}
+	return i
+}
+
+func columnItems(columns []column) [][]string {
+	result := make([][]string, 0, len(columns))
+	for _, c := range columns {
+		ids := make([]string, 0, len(c.items))
+		for _, i := range c.items {
+			ids = append(ids, i.ID())
+		}
+		result = append(result, ids)
+	}
+	return result
+}
+
+func TestBuildColumns(t *testing.T) {
+	field := statusField()
+	todo := field.Options()[0]
+	done := field.Options()[1]
+	items := []queries.ProjectItem{
+		item("1", "write docs", &done),
+		item("2", "fix bug", &todo),
+		item("3", "triage", nil),
+		item("4", "fix tests", &todo),
+	}
+
+	f, err := filter.Parse("")
+	assert.NoError(t, err)
+	columns := buildColumns(items, field, f)
+	assert.Equal(t, [][]string{{"3"}, {"2", "4"}, {"1"}}, columnItems(columns))
+	assert.Equal(t, "No Status (1)", columns[0].title(field))
+	assert.Equal(t, "Todo (2)", columns[1].title(field))
+
+	f, err = filter.Parse("fix")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{}, {"2", "4"}, {}}, columnItems(buildColumns(items, field, f)))
+
+	// archived items are left out
+	items[3].IsArchived = true
+	assert.Equal(t, [][]string{{}, {"2"}, {}}, columnItems(buildColumns(items, field, f)))
+}
+
+func TestSetItemOption(t *testing.T) {
+	field := statusField()
+	todo := field.Options()[0]
+	done := field.Options()[1]
+
+	i := item("1", "write docs", &todo)
+	setItemOption(&i, field, done)
+	assert.Equal(t, "done ID", itemOptionID(i, field))
+	assert.Len(t, i.FieldValues.Nodes, 1)
+
+	setItemOption(&i, field, queries.SingleSelectFieldOptions{})
+	assert.Equal(t, "", itemOptionID(i, field))
+	assert.Len(t, i.FieldValues.Nodes, 0)
+}
+
+func TestColumnField(t *testing.T) {
+	text := queries.ProjectField{TypeName: "ProjectV2Field"}
+	text.Field.Name = "Notes"
+	text.Field.DataType = "TEXT"
+	fields := []queries.ProjectField{text, statusField()}
+
+	field, err := columnField(fields, "status")
+	assert.NoError(t, err)
+	assert.Equal(t, "status ID", field.ID())
+
+	_, err = columnField(fields, "Notes")
+	assert.EqualError(t, err, `field "Notes" is not a single select field`)
+
+	_, err = columnField(fields, "Priority")
+	assert.EqualError(t, err, `field "Priority" not found`)
+}
+
+func TestMoveItem(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// set option
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemFieldValue.*","variables":{"input":{"projectId":"project ID","itemId":"item ID","fieldId":"status ID","value":{"singleSelectOptionId":"done ID"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+
+	// clear value
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation ClearItemFieldValue.*","variables":{"input":{"projectId":"project ID","itemId":"item ID","fieldId":"status ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"clearProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	config := boardConfig{client: client}
+	field := statusField()
+
+	err = moveItem(config, "project ID", "item ID", field, field.Options()[1])
+	assert.NoError(t, err)
+
+	err = moveItem(config, "project ID", "item ID", field, queries.SingleSelectFieldOptions{})
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+}
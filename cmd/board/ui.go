@@ -0,0 +1,240 @@
+package board
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/github/gh-projects/filter"
+	"github.com/github/gh-projects/queries"
+	"github.com/rivo/tview"
+)
+
+// board is the state of the interactive board.
+type board struct {
+	config  boardConfig
+	project *queries.Project
+	field   queries.ProjectField
+	filter  *filter.Filter
+	query   string
+
+	app     *tview.Application
+	columns []column
+	lists   []*tview.List
+	input   *tview.InputField
+	status  *tview.TextView
+	focused int
+	// moving is set while a move is sent to the API, other moves are ignored until it is done
+	moving bool
+}
+
+const helpText = "←/→ column  ↑/↓ item  </> move  enter open  / filter  q quit"
+
+func (b *board) run() error {
+	b.app = tview.NewApplication()
+
+	// one column for each option, and one for the items without a value
+	columns := tview.NewFlex()
+	for i := 0; i <= len(b.field.Options()); i++ {
+		list := tview.NewList().SetWrapAround(false)
+		list.SetBorder(true)
+		b.lists = append(b.lists, list)
+		columns.AddItem(list, 0, 1, false)
+	}
+
+	b.input = tview.NewInputField().
+		SetLabel("Filter: ").
+		SetText(b.query)
+	b.input.SetDoneFunc(b.applyFilter)
+
+	b.status = tview.NewTextView().SetText(helpText)
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(columns, 0, 1, true).
+		AddItem(b.input, 1, 0, false).
+		AddItem(b.status, 1, 0, false)
+
+	b.refresh()
+	b.app.SetInputCapture(b.handleKey)
+
+	return b.app.SetRoot(layout, true).SetFocus(b.lists[b.focused]).Run()
+}
+
+// refresh rebuilds the columns from the items, keeping the selected item of each column where possible.
+func (b *board) refresh() {
+	b.columns = buildColumns(b.project.Items.Nodes, b.field, b.filter)
+	for i, c := range b.columns {
+		list := b.lists[i]
+		current := list.GetCurrentItem()
+		list.Clear()
+		list.SetTitle(" " + c.title(b.field) + " ")
+		for _, item := range c.items {
+			list.AddItem(item.Title(), itemDetails(item), 0, nil)
+		}
+		if current >= list.GetItemCount() {
+			current = list.GetItemCount() - 1
+		}
+		if current >= 0 {
+			list.SetCurrentItem(current)
+		}
+	}
+}
+
+// itemDetails is the secondary text of a card, such as cli/go-gh#123.
+func itemDetails(item queries.ProjectItem) string {
+	if item.Number() == 0 {
+		return item.Type()
+	}
+	return fmt.Sprintf("%s#%d", item.Repo(), item.Number())
+}
+
+func (b *board) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	if b.input.HasFocus() {
+		return event
+	}
+
+	switch event.Key() {
+	case tcell.KeyEscape:
+		b.app.Stop()
+		return nil
+	case tcell.KeyLeft:
+		b.focus(b.focused - 1)
+		return nil
+	case tcell.KeyRight:
+		b.focus(b.focused + 1)
+		return nil
+	case tcell.KeyEnter:
+		b.open()
+		return nil
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'q':
+			b.app.Stop()
+		case 'h':
+			b.focus(b.focused - 1)
+		case 'l':
+			b.focus(b.focused + 1)
+		case 'k':
+			return tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
+		case 'j':
+			return tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
+		case '<', 'H':
+			b.move(-1)
+		case '>', 'L':
+			b.move(1)
+		case 'o':
+			b.open()
+		case '/':
+			b.app.SetFocus(b.input)
+		default:
+			return event
+		}
+		return nil
+	}
+	return event
+}
+
+func (b *board) focus(i int) {
+	if i < 0 || i >= len(b.lists) {
+		return
+	}
+	b.focused = i
+	b.app.SetFocus(b.lists[i])
+}
+
+// selected returns the selected item of the focused column, or nil if the column is empty.
+func (b *board) selected() *queries.ProjectItem {
+	c := b.columns[b.focused]
+	i := b.lists[b.focused].GetCurrentItem()
+	if i < 0 || i >= len(c.items) {
+		return nil
+	}
+	return b.item(c.items[i].ID())
+}
+
+// item returns the item of the project with the given ID, or nil if there is none.
+func (b *board) item(id string) *queries.ProjectItem {
+	for i := range b.project.Items.Nodes {
+		if b.project.Items.Nodes[i].ID() == id {
+			return &b.project.Items.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// move moves the selected item to the column before or after its column. The mutation runs in the
+// background so that the board is drawn meanwhile, and the board follows the item once it is done.
+func (b *board) move(offset int) {
+	item := b.selected()
+	target := b.focused + offset
+	if b.moving || item == nil || target < 0 || target >= len(b.columns) {
+		return
+	}
+
+	id := item.ID()
+	title := item.Title()
+	option := b.columns[target].option
+	b.moving = true
+	b.status.SetText(fmt.Sprintf("Moving %q...", title))
+	go func() {
+		err := moveItem(b.config, b.project.ID, id, b.field, option)
+		b.app.QueueUpdateDraw(func() {
+			b.moving = false
+			if err != nil {
+				b.status.SetText(fmt.Sprintf("Failed to move %q: %v", title, err))
+				return
+			}
+			b.moved(id, target, option)
+		})
+	}()
+}
+
+// moved updates the board after an item has been moved to the column at target, and selects it there.
+func (b *board) moved(id string, target int, option queries.SingleSelectFieldOptions) {
+	item := b.item(id)
+	if item == nil {
+		return
+	}
+	setItemOption(item, b.field, option)
+	b.refresh()
+	b.status.SetText(fmt.Sprintf("Moved %q to %s", item.Title(), b.columns[target].name(b.field)))
+
+	for i, c := range b.columns[target].items {
+		if c.ID() == id {
+			b.lists[target].SetCurrentItem(i)
+		}
+	}
+	b.focus(target)
+}
+
+func (b *board) open() {
+	item := b.selected()
+	if item == nil {
+		return
+	}
+	if item.URL() == "" {
+		b.status.SetText("Draft issues cannot be opened in the browser")
+		return
+	}
+	if err := b.config.URLOpener(item.URL()); err != nil {
+		b.status.SetText(fmt.Sprintf("Failed to open %s: %v", item.URL(), err))
+	}
+}
+
+func (b *board) applyFilter(key tcell.Key) {
+	if key == tcell.KeyEscape {
+		b.input.SetText(b.query)
+		b.focus(b.focused)
+		return
+	}
+
+	f, err := filter.Parse(b.input.GetText())
+	if err != nil {
+		b.status.SetText(err.Error())
+		return
+	}
+	b.filter = f
+	b.query = b.input.GetText()
+	b.refresh()
+	b.status.SetText(helpText)
+	b.focus(b.focused)
+}
@@ -0,0 +1,321 @@
+package collaborator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type collaboratorOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	logins    []string
+	teams     []string
+	role      string
+	projectID string
+	format    string
+}
+
+type collaboratorConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   collaboratorOpts
+}
+
+// UpdateProjectV2CollaboratorsInput is an input type of UpdateProjectV2Collaborators.
+type UpdateProjectV2CollaboratorsInput struct {
+	ProjectID     githubv4.ID       `json:"projectId"`
+	Collaborators []ProjectV2Collab `json:"collaborators"`
+}
+
+// ProjectV2Collab is a user or team collaborator with a role, used by UpdateProjectV2CollaboratorsInput.
+type ProjectV2Collab struct {
+	UserID *githubv4.ID `json:"userId,omitempty"`
+	TeamID *githubv4.ID `json:"teamId,omitempty"`
+	Role   string       `json:"role"`
+}
+
+type updateCollaboratorsMutation struct {
+	UpdateProjectV2Collaborators struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"updateProjectV2Collaborators(input:$input)"`
+}
+
+// roleNone removes a collaborator from a project.
+const roleNone = "NONE"
+
+var roles = []string{"READER", "WRITER", "ADMIN"}
+
+func NewCmdList(f *cmdutil.Factory, runF func(config collaboratorConfig) error) *cobra.Command {
+	opts := collaboratorOpts{}
+	listCmd := &cobra.Command{
+		Short: "List the collaborators of a project",
+		Use:   "collaborator-list [number]",
+		Example: `
+# list the collaborators of org github's project 1
+gh projects collaborator-list 1 --org github
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCmd(opts, args, runList)
+		},
+	}
+
+	addOwnerFlags(listCmd, &opts)
+	listCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	return listCmd
+}
+
+func NewCmdAdd(f *cmdutil.Factory, runF func(config collaboratorConfig) error) *cobra.Command {
+	opts := collaboratorOpts{}
+	addCmd := &cobra.Command{
+		Short: "Add collaborators to a project or change their role",
+		Use:   "collaborator-add [number]",
+		Example: `
+# give user monalisa write access to org github's project 1
+gh projects collaborator-add 1 --org github --login monalisa --role writer
+
+# give the teams github/core and github/docs read access to org github's project 1
+gh projects collaborator-add 1 --org github --team github/core --team github/docs --role reader
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCmd(opts, args, runAdd)
+		},
+	}
+
+	addOwnerFlags(addCmd, &opts)
+	addCollaboratorFlags(addCmd, &opts)
+	addCmd.Flags().StringVar(&opts.role, "role", "", fmt.Sprintf("Role of the collaborators, one of %s.", strings.Join(roles, ", ")))
+
+	_ = addCmd.MarkFlagRequired("role")
+
+	return addCmd
+}
+
+func NewCmdRemove(f *cmdutil.Factory, runF func(config collaboratorConfig) error) *cobra.Command {
+	opts := collaboratorOpts{}
+	removeCmd := &cobra.Command{
+		Short: "Remove collaborators from a project",
+		Use:   "collaborator-remove [number]",
+		Example: `
+# remove user monalisa from org github's project 1
+gh projects collaborator-remove 1 --org github --login monalisa
+
+# remove the team github/core from org github's project 1
+gh projects collaborator-remove 1 --org github --team github/core
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCmd(opts, args, runRemove)
+		},
+	}
+
+	addOwnerFlags(removeCmd, &opts)
+	addCollaboratorFlags(removeCmd, &opts)
+
+	return removeCmd
+}
+
+func addOwnerFlags(cmd *cobra.Command, opts *collaboratorOpts) {
+	cmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	cmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+
+	// owner can be a user or an org
+	cmd.MarkFlagsMutuallyExclusive("user", "org")
+}
+
+func addCollaboratorFlags(cmd *cobra.Command, opts *collaboratorOpts) {
+	cmd.Flags().StringSliceVar(&opts.logins, "login", nil, "Login of a user collaborator. Use \"@me\" for the current user. Can be repeated.")
+	cmd.Flags().StringSliceVar(&opts.teams, "team", nil, "Team collaborator of the form ORG/SLUG. Can be repeated.")
+}
+
+// runCmd builds the config shared by the collaborator commands.
+func runCmd(opts collaboratorOpts, args []string, run func(config collaboratorConfig) error) error {
+	client, err := queries.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		opts.number, err = strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal := term.FromEnv()
+	termWidth, _, err := terminal.Size()
+	if err != nil {
+		// set a static width in case of error
+		termWidth = 80
+	}
+	t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+	config := collaboratorConfig{
+		tp:     t,
+		client: client,
+		opts:   opts,
+	}
+	return run(config)
+}
+
+func runList(config collaboratorConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	project, err := projectFromOpts(config)
+	if err != nil {
+		return err
+	}
+
+	collaborators, err := queries.ProjectCollaborators(config.client, project.ID)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config, collaborators)
+	}
+
+	return printList(config, project, collaborators)
+}
+
+func runAdd(config collaboratorConfig) error {
+	role := strings.ToUpper(config.opts.role)
+	if !validRole(role) {
+		return fmt.Errorf("role must be one of %s", strings.Join(roles, ", "))
+	}
+
+	return updateCollaborators(config, role)
+}
+
+func runRemove(config collaboratorConfig) error {
+	return updateCollaborators(config, roleNone)
+}
+
+func validRole(role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func projectFromOpts(config collaboratorConfig) (*queries.Project, error) {
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return queries.NewProject(config.client, owner, config.opts.number, false)
+}
+
+func updateCollaborators(config collaboratorConfig, role string) error {
+	if len(config.opts.logins) == 0 && len(config.opts.teams) == 0 {
+		return fmt.Errorf("one of --login or --team must be provided")
+	}
+
+	project, err := projectFromOpts(config)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	collaborators := make([]ProjectV2Collab, 0, len(config.opts.logins)+len(config.opts.teams))
+	names := make([]string, 0, len(config.opts.logins)+len(config.opts.teams))
+	for _, login := range config.opts.logins {
+		// "@me" is the current user, as for --user
+		user, err := queries.NewOwner(config.client, login, "")
+		if err != nil {
+			return err
+		}
+		userID := githubv4.ID(user.ID)
+		collaborators = append(collaborators, ProjectV2Collab{UserID: &userID, Role: role})
+		names = append(names, login)
+	}
+	for _, ref := range config.opts.teams {
+		team, err := queries.NewTeam(config.client, ref)
+		if err != nil {
+			return err
+		}
+		teamID := githubv4.ID(team.ID)
+		collaborators = append(collaborators, ProjectV2Collab{TeamID: &teamID, Role: role})
+		names = append(names, team.CombinedSlug)
+	}
+
+	query, variables := updateCollaboratorsArgs(config, collaborators)
+	err = config.client.Mutate("UpdateProjectCollaborators", query, variables)
+	if err != nil {
+		return err
+	}
+
+	return printResults(config, project, names, role)
+}
+
+func updateCollaboratorsArgs(config collaboratorConfig, collaborators []ProjectV2Collab) (*updateCollaboratorsMutation, map[string]interface{}) {
+	return &updateCollaboratorsMutation{}, map[string]interface{}{
+		"input": UpdateProjectV2CollaboratorsInput{
+			ProjectID:     githubv4.ID(config.opts.projectID),
+			Collaborators: collaborators,
+		},
+	}
+}
+
+func printResults(config collaboratorConfig, project *queries.Project, names []string, role string) error {
+	// using table printer here for consistency in case it ends up being needed in the future
+	if role == roleNone {
+		config.tp.AddField(fmt.Sprintf("Removed %s from project %d", strings.Join(names, ", "), project.Number))
+	} else {
+		config.tp.AddField(fmt.Sprintf("Added %s to project %d as %s", strings.Join(names, ", "), project.Number, role))
+	}
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+func printList(config collaboratorConfig, project *queries.Project, collaborators []queries.ProjectCollaborator) error {
+	if len(collaborators) == 0 {
+		config.tp.AddField(fmt.Sprintf("Project %d has no collaborators", project.Number))
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	config.tp.AddField("Type")
+	config.tp.AddField("Name")
+	config.tp.AddField("Role")
+	config.tp.EndRow()
+
+	for _, c := range collaborators {
+		config.tp.AddField(c.Type())
+		config.tp.AddField(c.Name())
+		config.tp.AddField(c.Role)
+		config.tp.EndRow()
+	}
+
+	return config.tp.Render()
+}
+
+func printJSON(config collaboratorConfig, collaborators []queries.ProjectCollaborator) error {
+	b, err := format.JSONProjectCollaborators(collaborators)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
@@ -0,0 +1,546 @@
+package collaborator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func mockCollaborators() {
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectCollaborators.*",
+			"variables": map[string]interface{}{
+				"id":    "project ID",
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"collaborators": map[string]interface{}{
+						"totalCount": 2,
+						"edges": []map[string]interface{}{
+							{
+								"role": "ADMIN",
+								"node": map[string]interface{}{
+									"__typename": "User",
+									"login":      "monalisa",
+								},
+							},
+							{
+								"role": "READER",
+								"node": map[string]interface{}{
+									"__typename":   "Team",
+									"combinedSlug": "github/core",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestRunList(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+	mockCollaborators()
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := collaboratorConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: collaboratorOpts{
+			orgOwner: "github",
+			number:   1,
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Type\tName\tRole\nUser\tmonalisa\tADMIN\nTeam\tgithub/core\tREADER\n",
+		buf.String())
+}
+
+func TestRunList_JSON(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+	mockCollaborators()
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := collaboratorConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: collaboratorOpts{
+			orgOwner: "github",
+			number:   1,
+			format:   "json",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"collaborators":[{"type":"User","name":"monalisa","role":"ADMIN"},{"type":"Team","name":"github/core","role":"READER"}],"totalCount":2}`,
+		buf.String())
+}
+
+func TestRunAdd(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "user ID",
+				},
+			},
+		})
+
+	// get team ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query Team.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+				"slug":  "core",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"team": map[string]interface{}{
+						"id":           "team ID",
+						"combinedSlug": "github/core",
+					},
+				},
+			},
+		})
+
+	// update collaborators
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateProjectCollaborators.*","variables":{"input":{"projectId":"project ID","collaborators":\[{"userId":"user ID","role":"WRITER"},{"teamId":"team ID","role":"WRITER"}\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Collaborators": map[string]interface{}{
+					"clientMutationId": "",
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := collaboratorConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: collaboratorOpts{
+			orgOwner: "github",
+			number:   1,
+			logins:   []string{"monalisa"},
+			teams:    []string{"github/core"},
+			role:     "writer",
+		},
+		client: client,
+	}
+
+	err = runAdd(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Added monalisa, github/core to project 1 as WRITER\n",
+		buf.String())
+}
+
+func TestRunAdd_Me(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get viewer ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerLogin.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"id": "user ID",
+				},
+			},
+		})
+
+	// update collaborators
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateProjectCollaborators.*","variables":{"input":{"projectId":"project ID","collaborators":\[{"userId":"user ID","role":"READER"}\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Collaborators": map[string]interface{}{
+					"clientMutationId": "",
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := collaboratorConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: collaboratorOpts{
+			orgOwner: "github",
+			number:   1,
+			logins:   []string{"@me"},
+			role:     "reader",
+		},
+		client: client,
+	}
+
+	err = runAdd(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Added @me to project 1 as READER\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunRemove(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "user ID",
+				},
+			},
+		})
+
+	// update collaborators
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateProjectCollaborators.*","variables":{"input":{"projectId":"project ID","collaborators":\[{"userId":"user ID","role":"NONE"}\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Collaborators": map[string]interface{}{
+					"clientMutationId": "",
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := collaboratorConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: collaboratorOpts{
+			orgOwner: "github",
+			number:   1,
+			logins:   []string{"monalisa"},
+		},
+		client: client,
+	}
+
+	err = runRemove(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Removed monalisa from project 1\n",
+		buf.String())
+}
+
+func TestRunAdd_InvalidRole(t *testing.T) {
+	config := collaboratorConfig{
+		tp: tableprinter.New(&bytes.Buffer{}, false, 0),
+		opts: collaboratorOpts{
+			orgOwner: "github",
+			number:   1,
+			logins:   []string{"monalisa"},
+			role:     "owner",
+		},
+	}
+
+	err := runAdd(config)
+	assert.EqualError(t, err, "role must be one of READER, WRITER, ADMIN")
+}
@@ -15,11 +15,14 @@ import (
 )
 
 type createOpts struct {
-	title     string
-	userOwner string
-	orgOwner  string
-	ownerID   string
-	format    string
+	title              string
+	userOwner          string
+	orgOwner           string
+	ownerID            string
+	fromTemplate       int
+	templateOrgOwner   string
+	includeDraftIssues bool
+	format             string
 }
 
 type createConfig struct {
@@ -34,6 +37,12 @@ type createProjectMutation struct {
 	} `graphql:"createProjectV2(input:$input)"`
 }
 
+type copyProjectMutation struct {
+	CopyProjectV2 struct {
+		ProjectV2 queries.Project `graphql:"projectV2"`
+	} `graphql:"copyProjectV2(input:$input)"`
+}
+
 func NewCmdCreate(f *cmdutil.Factory, runF func(config createConfig) error) *cobra.Command {
 	opts := createOpts{}
 	createCmd := &cobra.Command{
@@ -49,6 +58,9 @@ gh projects create --org github --title "a new project"
 # create a new project owned by the current user with title "a new project"
 gh projects create --user '@me' --title "a new project"
 
+# create a new project owned by org github from its template project 3, including its draft issues
+gh projects create --org github --from-template 3
+
 # add --format=json to output in JSON format
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -76,9 +88,11 @@ gh projects create --user '@me' --title "a new project"
 	createCmd.Flags().StringVar(&opts.title, "title", "", "Title of the project. Titles do not need to be unique.")
 	createCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
 	createCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	createCmd.Flags().IntVar(&opts.fromTemplate, "from-template", 0, "Number of a template project to create the project from. The title defaults to the title of the template.")
+	createCmd.Flags().StringVar(&opts.templateOrgOwner, "template-org", "", "Login of the organization owning the template. Defaults to the organization owner of the new project.")
+	createCmd.Flags().BoolVar(&opts.includeDraftIssues, "drafts", true, "Include draft issues from the template. Only used with --from-template.")
 	createCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
 
-	_ = createCmd.MarkFlagRequired("title")
 	createCmd.MarkFlagsMutuallyExclusive("user", "org")
 
 	return createCmd
@@ -89,12 +103,21 @@ func runCreate(config createConfig) error {
 		return fmt.Errorf("format must be 'json'")
 	}
 
+	if config.opts.title == "" && config.opts.fromTemplate == 0 {
+		return fmt.Errorf("title must be provided unless creating from a template")
+	}
+
 	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
 	if err != nil {
 		return err
 	}
 
 	config.opts.ownerID = owner.ID
+
+	if config.opts.fromTemplate != 0 {
+		return runCreateFromTemplate(config, owner)
+	}
+
 	query, variables := createArgs(config)
 
 	err = config.client.Mutate("CreateProjectV2", query, variables)
@@ -122,6 +145,59 @@ func createArgs(config createConfig) (*createProjectMutation, map[string]interfa
 	}
 }
 
+// runCreateFromTemplate copies a template project to the owner of the new project.
+func runCreateFromTemplate(config createConfig, owner *queries.Owner) error {
+	templateOwner := owner
+	if config.opts.templateOrgOwner != "" {
+		var err error
+		templateOwner, err = queries.NewOwner(config.client, "", config.opts.templateOrgOwner)
+		if err != nil {
+			return err
+		}
+	} else if owner.Type != queries.OrgOwner {
+		return fmt.Errorf("templates are owned by organizations, use --template-org to set the owner of the template")
+	}
+
+	template, err := queries.NewProject(config.client, templateOwner, config.opts.fromTemplate, false)
+	if err != nil {
+		return err
+	}
+	if !template.Template {
+		return fmt.Errorf("project %d is not a template, use 'copy' to copy a project that is not a template", config.opts.fromTemplate)
+	}
+
+	if config.opts.title == "" {
+		config.opts.title = template.Title
+	}
+
+	query, variables := copyTemplateArgs(config, template.ID)
+	err = config.client.Mutate("CopyProjectV2", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config, query.CopyProjectV2.ProjectV2)
+	}
+
+	return printResults(config, query.CopyProjectV2.ProjectV2)
+}
+
+func copyTemplateArgs(config createConfig, templateID string) (*copyProjectMutation, map[string]interface{}) {
+	return &copyProjectMutation{}, map[string]interface{}{
+		"input": githubv4.CopyProjectV2Input{
+			OwnerID:            githubv4.ID(config.opts.ownerID),
+			ProjectID:          githubv4.ID(templateID),
+			Title:              githubv4.String(config.opts.title),
+			IncludeDraftIssues: githubv4.NewBoolean(githubv4.Boolean(config.opts.includeDraftIssues)),
+		},
+		"firstItems":  githubv4.Int(0),
+		"afterItems":  (*githubv4.String)(nil),
+		"firstFields": githubv4.Int(0),
+		"afterFields": (*githubv4.String)(nil),
+	}
+}
+
 func printResults(config createConfig, project queries.Project) error {
 	// using table printer here for consistency in case it ends up being needed in the future
 	config.tp.AddField(fmt.Sprintf("Created project '%s'", project.Title))
@@ -194,3 +194,158 @@ func TestRunCreate_Me(t *testing.T) {
 		"Created project 'a title'\nhttp://a-url.com\n",
 		buf.String())
 }
+
+func TestRunCreate_FromTemplate(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]string{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id":    "an ID",
+					"login": "github",
+				},
+			},
+		})
+
+	// get template project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      3,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":       "template ID",
+						"title":    "a template",
+						"template": true,
+					},
+				},
+			},
+		})
+
+	// copy template
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CopyProjectV2.*","variables":{"afterFields":null,"afterItems":null,"firstFields":0,"firstItems":0,"input":{"projectId":"template ID","ownerId":"an ID","title":"a template","includeDraftIssues":true}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"copyProjectV2": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"title": "a template",
+						"url":   "http://a-url.com",
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := createConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: createOpts{
+			orgOwner:           "github",
+			fromTemplate:       3,
+			includeDraftIssues: true,
+		},
+		client: client,
+	}
+
+	err = runCreate(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Created project 'a template'\nhttp://a-url.com\n",
+		buf.String())
+}
+
+func TestRunCreate_FromTemplateNotTemplate(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]string{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id":    "an ID",
+					"login": "github",
+				},
+			},
+		})
+
+	// get project that is not a template
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      3,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":       "project ID",
+						"template": false,
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	config := createConfig{
+		tp: tableprinter.New(&bytes.Buffer{}, false, 0),
+		opts: createOpts{
+			orgOwner:     "github",
+			fromTemplate: 3,
+		},
+		client: client,
+	}
+
+	err = runCreate(config)
+	assert.EqualError(t, err, "project 3 is not a template, use 'copy' to copy a project that is not a template")
+}
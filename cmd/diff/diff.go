@@ -0,0 +1,389 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+type diffOpts struct {
+	sourceNumber    int
+	targetNumber    int
+	sourceUserOwner string
+	sourceOrgOwner  string
+	targetUserOwner string
+	targetOrgOwner  string
+	format          string
+}
+
+type diffConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   diffOpts
+}
+
+const (
+	onlyInSource = "only in source"
+	onlyInTarget = "only in target"
+	changed      = "changed"
+)
+
+// projectDiff holds the differences between a source and a target project.
+type projectDiff struct {
+	Fields []fieldDiff `json:"fields"`
+	Items  []itemDiff  `json:"items"`
+}
+
+type fieldDiff struct {
+	Name     string   `json:"name"`
+	DataType string   `json:"dataType"`
+	Change   string   `json:"change"`
+	Details  []string `json:"details,omitempty"`
+}
+
+type itemDiff struct {
+	Item   string      `json:"item"`
+	Title  string      `json:"title"`
+	Change string      `json:"change"`
+	Values []valueDiff `json:"values,omitempty"`
+}
+
+type valueDiff struct {
+	Field  string `json:"field"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+func NewCmdDiff(f *cmdutil.Factory, runF func(config diffConfig) error) *cobra.Command {
+	opts := diffOpts{}
+	diffCmd := &cobra.Command{
+		Short: "Compare the fields and items of two projects",
+		Use:   "diff [source-number] [target-number]",
+		Long: `
+Compare the fields, single select options, items and item field values of two projects.
+Items are matched by URL, and draft issues by title. The target owner defaults to the source owner.`,
+		Example: `
+# compare org github's template project 1 with its project 2
+gh projects diff 1 2 --source-org github
+
+# compare user monalisa's project 1 with org github's project 5
+gh projects diff 1 5 --source-user monalisa --target-org github
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) >= 1 {
+				opts.sourceNumber, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+			if len(args) == 2 {
+				opts.targetNumber, err = strconv.Atoi(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+			config := diffConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runDiff(config)
+		},
+	}
+
+	diffCmd.Flags().StringVar(&opts.sourceUserOwner, "source-user", "", "Login of the source user owner. Use \"@me\" for the current user.")
+	diffCmd.Flags().StringVar(&opts.sourceOrgOwner, "source-org", "", "Login of the source organization owner.")
+	diffCmd.Flags().StringVar(&opts.targetUserOwner, "target-user", "", "Login of the target user owner. Use \"@me\" for the current user.")
+	diffCmd.Flags().StringVar(&opts.targetOrgOwner, "target-org", "", "Login of the target organization owner.")
+	diffCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	diffCmd.MarkFlagsMutuallyExclusive("source-user", "source-org")
+	diffCmd.MarkFlagsMutuallyExclusive("target-user", "target-org")
+
+	return diffCmd
+}
+
+func runDiff(config diffConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	sourceOwner, err := queries.NewOwner(config.client, config.opts.sourceUserOwner, config.opts.sourceOrgOwner)
+	if err != nil {
+		return err
+	}
+
+	targetOwner := sourceOwner
+	if config.opts.targetUserOwner != "" || config.opts.targetOrgOwner != "" {
+		targetOwner, err = queries.NewOwner(config.client, config.opts.targetUserOwner, config.opts.targetOrgOwner)
+		if err != nil {
+			return err
+		}
+	}
+
+	source, err := projectWithItems(config, sourceOwner, config.opts.sourceNumber)
+	if err != nil {
+		return err
+	}
+
+	target, err := projectWithItems(config, targetOwner, config.opts.targetNumber)
+	if err != nil {
+		return err
+	}
+
+	d := diffProjects(source, target)
+
+	if config.opts.format == "json" {
+		return printJSON(config, d)
+	}
+
+	return printResults(config, d)
+}
+
+// projectWithItems returns a project with all of its items and fields.
+func projectWithItems(config diffConfig, owner *queries.Owner, number int) (*queries.Project, error) {
+	// no need to fetch the project if we already have the number
+	if number == 0 {
+		project, err := queries.NewProject(config.client, owner, number, false)
+		if err != nil {
+			return nil, err
+		}
+		number = project.Number
+	}
+
+	return queries.ProjectItems(config.client, owner, number, 0)
+}
+
+func diffProjects(source, target *queries.Project) projectDiff {
+	return projectDiff{
+		Fields: diffFields(source.Fields.Nodes, target.Fields.Nodes),
+		Items:  diffItems(source, target),
+	}
+}
+
+func diffFields(source, target []queries.ProjectField) []fieldDiff {
+	diffs := make([]fieldDiff, 0)
+	targetByName := make(map[string]queries.ProjectField)
+	for _, f := range target {
+		targetByName[f.Name()] = f
+	}
+
+	sourceNames := make(map[string]bool)
+	for _, s := range source {
+		sourceNames[s.Name()] = true
+		t, ok := targetByName[s.Name()]
+		if !ok {
+			diffs = append(diffs, fieldDiff{Name: s.Name(), DataType: s.DataType(), Change: onlyInSource})
+			continue
+		}
+
+		details := make([]string, 0)
+		if s.DataType() != t.DataType() {
+			details = append(details, fmt.Sprintf("data type %s in source, %s in target", s.DataType(), t.DataType()))
+		}
+		details = append(details, diffOptions(s.Options(), t.Options())...)
+		if len(details) > 0 {
+			diffs = append(diffs, fieldDiff{Name: s.Name(), DataType: s.DataType(), Change: changed, Details: details})
+		}
+	}
+
+	for _, t := range target {
+		if !sourceNames[t.Name()] {
+			diffs = append(diffs, fieldDiff{Name: t.Name(), DataType: t.DataType(), Change: onlyInTarget})
+		}
+	}
+
+	return diffs
+}
+
+func diffOptions(source, target []queries.SingleSelectFieldOptions) []string {
+	details := make([]string, 0)
+	targetNames := make(map[string]bool)
+	for _, o := range target {
+		targetNames[o.Name] = true
+	}
+	sourceNames := make(map[string]bool)
+	for _, o := range source {
+		sourceNames[o.Name] = true
+		if !targetNames[o.Name] {
+			details = append(details, fmt.Sprintf("option %q only in source", o.Name))
+		}
+	}
+	for _, o := range target {
+		if !sourceNames[o.Name] {
+			details = append(details, fmt.Sprintf("option %q only in target", o.Name))
+		}
+	}
+	return details
+}
+
+// itemKey identifies the same item across projects. Issues and pull requests are matched
+// by URL, while draft issues only exist within a project and are matched by title.
+func itemKey(item queries.ProjectItem) string {
+	if item.URL() != "" {
+		return item.URL()
+	}
+	return fmt.Sprintf("%s: %s", item.Type(), item.Title())
+}
+
+func diffItems(source, target *queries.Project) []itemDiff {
+	diffs := make([]itemDiff, 0)
+
+	// only compare values of fields that exist in both projects, differences in fields are reported separately
+	sourceFields := make(map[string]bool)
+	for _, f := range source.Fields.Nodes {
+		sourceFields[f.Name()] = true
+	}
+	sharedFields := make(map[string]bool)
+	for _, f := range target.Fields.Nodes {
+		if sourceFields[f.Name()] {
+			sharedFields[f.Name()] = true
+		}
+	}
+
+	targetByKey := make(map[string]queries.ProjectItem)
+	for _, i := range target.Items.Nodes {
+		targetByKey[itemKey(i)] = i
+	}
+
+	sourceKeys := make(map[string]bool)
+	for _, s := range source.Items.Nodes {
+		key := itemKey(s)
+		sourceKeys[key] = true
+		t, ok := targetByKey[key]
+		if !ok {
+			diffs = append(diffs, itemDiff{Item: key, Title: s.Title(), Change: onlyInSource})
+			continue
+		}
+
+		values := diffValues(format.FieldValuesText(s), format.FieldValuesText(t), sharedFields)
+		if len(values) > 0 {
+			diffs = append(diffs, itemDiff{Item: key, Title: s.Title(), Change: changed, Values: values})
+		}
+	}
+
+	for _, t := range target.Items.Nodes {
+		key := itemKey(t)
+		if !sourceKeys[key] {
+			diffs = append(diffs, itemDiff{Item: key, Title: t.Title(), Change: onlyInTarget})
+		}
+	}
+
+	return diffs
+}
+
+func diffValues(source, target map[string]string, fields map[string]bool) []valueDiff {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]valueDiff, 0)
+	for _, name := range names {
+		if source[name] != target[name] {
+			values = append(values, valueDiff{Field: name, Source: source[name], Target: target[name]})
+		}
+	}
+	return values
+}
+
+func printResults(config diffConfig, d projectDiff) error {
+	if len(d.Fields) == 0 && len(d.Items) == 0 {
+		config.tp.AddField("Projects have the same fields and items")
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	if len(d.Fields) > 0 {
+		config.tp.AddField("Field")
+		config.tp.AddField("DataType")
+		config.tp.AddField("Change")
+		config.tp.AddField("Details")
+		config.tp.EndRow()
+		for _, f := range d.Fields {
+			config.tp.AddField(f.Name)
+			config.tp.AddField(f.DataType)
+			config.tp.AddField(f.Change)
+			if len(f.Details) == 0 {
+				config.tp.AddField(" - ")
+			} else {
+				config.tp.AddField(strings.Join(f.Details, ", "))
+			}
+			config.tp.EndRow()
+		}
+	}
+
+	if len(d.Items) > 0 {
+		config.tp.AddField("Item")
+		config.tp.AddField("Change")
+		config.tp.AddField("Field")
+		config.tp.AddField("Source")
+		config.tp.AddField("Target")
+		config.tp.EndRow()
+		for _, i := range d.Items {
+			if len(i.Values) == 0 {
+				addItemRow(config, i, valueDiff{Field: " - ", Source: " - ", Target: " - "})
+				continue
+			}
+			for _, v := range i.Values {
+				addItemRow(config, i, v)
+			}
+		}
+	}
+
+	return config.tp.Render()
+}
+
+func addItemRow(config diffConfig, i itemDiff, v valueDiff) {
+	config.tp.AddField(i.Title)
+	config.tp.AddField(i.Change)
+	config.tp.AddField(v.Field)
+	config.tp.AddField(emptyAsDash(v.Source))
+	config.tp.AddField(emptyAsDash(v.Target))
+	config.tp.EndRow()
+}
+
+func emptyAsDash(s string) string {
+	if s == "" {
+		return " - "
+	}
+	return s
+}
+
+func printJSON(config diffConfig, d projectDiff) error {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
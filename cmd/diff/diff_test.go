@@ -0,0 +1,195 @@
+package diff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/github/gh-projects/queries"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func statusField(options ...string) map[string]interface{} {
+	opts := make([]map[string]interface{}, 0)
+	for _, o := range options {
+		opts = append(opts, map[string]interface{}{"id": o + " ID", "name": o})
+	}
+	return map[string]interface{}{
+		"__typename": "ProjectV2SingleSelectField",
+		"name":       "Status",
+		"id":         "status ID",
+		"dataType":   "SINGLE_SELECT",
+		"options":    opts,
+	}
+}
+
+func issueItem(title, url, status string) map[string]interface{} {
+	return map[string]interface{}{
+		"id": title + " ID",
+		"content": map[string]interface{}{
+			"__typename": "Issue",
+			"title":      title,
+			"url":        url,
+		},
+		"fieldValues": map[string]interface{}{
+			"nodes": []map[string]interface{}{
+				{
+					"__typename": "ProjectV2ItemFieldSingleSelectValue",
+					"name":       status,
+					"field": map[string]interface{}{
+						"__typename": "ProjectV2SingleSelectField",
+						"name":       "Status",
+					},
+				},
+			},
+		},
+	}
+}
+
+func mockProjectWithItems(number int, fields []map[string]interface{}, items []map[string]interface{}) {
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      number,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": fields,
+						},
+						"items": map[string]interface{}{
+							"nodes": items,
+						},
+					},
+				},
+			},
+		})
+}
+
+func mockDiff() {
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	mockProjectWithItems(1,
+		[]map[string]interface{}{
+			statusField("Todo", "Done"),
+			{"__typename": "ProjectV2Field", "name": "Estimate", "id": "estimate ID", "dataType": "NUMBER"},
+		},
+		[]map[string]interface{}{
+			issueItem("first issue", "https://github.com/cli/go-gh/issues/1", "Todo"),
+			issueItem("second issue", "https://github.com/cli/go-gh/issues/2", "Done"),
+		})
+
+	mockProjectWithItems(2,
+		[]map[string]interface{}{
+			statusField("Todo", "Done", "Blocked"),
+		},
+		[]map[string]interface{}{
+			issueItem("first issue", "https://github.com/cli/go-gh/issues/1", "Done"),
+			issueItem("third issue", "https://github.com/cli/go-gh/issues/3", "Todo"),
+		})
+}
+
+func TestRunDiff(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockDiff()
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := diffConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: diffOpts{
+			sourceNumber:    1,
+			targetNumber:    2,
+			sourceUserOwner: "monalisa",
+		},
+		client: client,
+	}
+
+	err = runDiff(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Field\tDataType\tChange\tDetails\n"+
+			"Status\tSINGLE_SELECT\tchanged\toption \"Blocked\" only in target\n"+
+			"Estimate\tNUMBER\tonly in source\t - \n"+
+			"Item\tChange\tField\tSource\tTarget\n"+
+			"first issue\tchanged\tStatus\tTodo\tDone\n"+
+			"second issue\tonly in source\t - \t - \t - \n"+
+			"third issue\tonly in target\t - \t - \t - \n",
+		buf.String())
+}
+
+func TestRunDiff_JSON(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockDiff()
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := diffConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: diffOpts{
+			sourceNumber:    1,
+			targetNumber:    2,
+			sourceUserOwner: "monalisa",
+			format:          "json",
+		},
+		client: client,
+	}
+
+	err = runDiff(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"fields":[{"name":"Status","dataType":"SINGLE_SELECT","change":"changed","details":["option \"Blocked\" only in target"]},{"name":"Estimate","dataType":"NUMBER","change":"only in source"}],"items":[{"item":"https://github.com/cli/go-gh/issues/1","title":"first issue","change":"changed","values":[{"field":"Status","source":"Todo","target":"Done"}]},{"item":"https://github.com/cli/go-gh/issues/2","title":"second issue","change":"only in source"},{"item":"https://github.com/cli/go-gh/issues/3","title":"third issue","change":"only in target"}]}`,
+		buf.String())
+}
+
+func TestItemKey(t *testing.T) {
+	draft := queries.ProjectItem{}
+	draft.Content.TypeName = "DraftIssue"
+	draft.Content.DraftIssue.Title = "a draft"
+	assert.Equal(t, "DraftIssue: a draft", itemKey(draft))
+
+	issue := queries.ProjectItem{}
+	issue.Content.TypeName = "Issue"
+	issue.Content.Issue.URL = "https://github.com/cli/go-gh/issues/1"
+	assert.Equal(t, "https://github.com/cli/go-gh/issues/1", itemKey(issue))
+}
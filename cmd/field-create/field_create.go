@@ -32,12 +32,6 @@ type createFieldConfig struct {
 	opts   createFieldOpts
 }
 
-type createProjectV2FieldMutation struct {
-	CreateProjectV2Field struct {
-		Field queries.ProjectField `graphql:"projectV2Field"`
-	} `graphql:"createProjectV2Field(input:$input)"`
-}
-
 func NewCmdCreateField(f *cmdutil.Factory, runF func(config createFieldConfig) error) *cobra.Command {
 	opts := createFieldOpts{}
 	createFieldCmd := &cobra.Command{
@@ -123,31 +117,29 @@ func runCreateField(config createFieldConfig) error {
 	}
 	config.opts.projectID = project.ID
 
-	query, variables := createFieldArgs(config)
-
-	err = config.client.Mutate("CreateField", query, variables)
+	field, err := queries.CreateField(config.client, createFieldInput(config))
 	if err != nil {
 		return err
 	}
 
 	if config.opts.format == "json" {
-		return printJSON(config, query.CreateProjectV2Field.Field)
+		return printJSON(config, *field)
 	}
 
-	return printResults(config, query.CreateProjectV2Field.Field)
+	return printResults(config, *field)
 }
 
-func createFieldArgs(config createFieldConfig) (*createProjectV2FieldMutation, map[string]interface{}) {
-	input := githubv4.CreateProjectV2FieldInput{
+func createFieldInput(config createFieldConfig) queries.CreateProjectV2FieldInput {
+	input := queries.CreateProjectV2FieldInput{
 		ProjectID: githubv4.ID(config.opts.projectID),
 		DataType:  githubv4.ProjectV2CustomFieldType(config.opts.dataType),
 		Name:      githubv4.String(config.opts.name),
 	}
 
 	if len(config.opts.singleSelectOptions) != 0 {
-		opts := make([]githubv4.ProjectV2SingleSelectFieldOptionInput, 0)
+		opts := make([]queries.ProjectV2SingleSelectFieldOptionInput, 0)
 		for _, opt := range config.opts.singleSelectOptions {
-			opts = append(opts, githubv4.ProjectV2SingleSelectFieldOptionInput{
+			opts = append(opts, queries.ProjectV2SingleSelectFieldOptionInput{
 				Name:  githubv4.String(opt),
 				Color: githubv4.ProjectV2SingleSelectFieldOptionColor("GRAY"),
 			})
@@ -155,9 +147,7 @@ func createFieldArgs(config createFieldConfig) (*createProjectV2FieldMutation, m
 		input.SingleSelectOptions = &opts
 	}
 
-	return &createProjectV2FieldMutation{}, map[string]interface{}{
-		"input": input,
-	}
+	return input
 }
 
 func printResults(config createFieldConfig, field queries.ProjectField) error {
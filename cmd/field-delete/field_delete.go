@@ -10,7 +10,6 @@ import (
 	"github.com/cli/go-gh/v2/pkg/term"
 	"github.com/github/gh-projects/format"
 	"github.com/github/gh-projects/queries"
-	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
 )
 
@@ -25,12 +24,6 @@ type deleteFieldConfig struct {
 	opts   deleteFieldOpts
 }
 
-type deleteProjectV2FieldMutation struct {
-	DeleteProjectV2Field struct {
-		Field queries.ProjectField `graphql:"projectV2Field"`
-	} `graphql:"deleteProjectV2Field(input:$input)"`
-}
-
 func NewCmdDeleteField(f *cmdutil.Factory, runF func(config deleteFieldConfig) error) *cobra.Command {
 	opts := deleteFieldOpts{}
 	deleteFieldCmd := &cobra.Command{
@@ -78,26 +71,16 @@ func runDeleteField(config deleteFieldConfig) error {
 		return fmt.Errorf("format must be 'json'")
 	}
 
-	query, variables := deleteFieldArgs(config)
-
-	err := config.client.Mutate("DeleteField", query, variables)
+	field, err := queries.DeleteField(config.client, config.opts.fieldID)
 	if err != nil {
 		return err
 	}
 
 	if config.opts.format == "json" {
-		return printJSON(config, query.DeleteProjectV2Field.Field)
+		return printJSON(config, *field)
 	}
 
-	return printResults(config, query.DeleteProjectV2Field.Field)
-}
-
-func deleteFieldArgs(config deleteFieldConfig) (*deleteProjectV2FieldMutation, map[string]interface{}) {
-	return &deleteProjectV2FieldMutation{}, map[string]interface{}{
-		"input": githubv4.DeleteProjectV2FieldInput{
-			FieldID: githubv4.ID(config.opts.fieldID),
-		},
-	}
+	return printResults(config, *field)
 }
 
 func printResults(config deleteFieldConfig, field queries.ProjectField) error {
@@ -1,47 +1,60 @@
 package itemadd
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
 
 	"github.com/cli/cli/v2/pkg/cmdutil"
 
 	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/repository"
 	"github.com/cli/go-gh/v2/pkg/tableprinter"
 	"github.com/cli/go-gh/v2/pkg/term"
 	"github.com/github/gh-projects/format"
 	"github.com/github/gh-projects/queries"
-	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
 )
 
 type addItemOpts struct {
-	userOwner string
-	orgOwner  string
-	number    int
-	itemURL   string
-	projectID string
-	itemID    string
-	format    string
+	userOwner  string
+	orgOwner   string
+	number     int
+	itemURL    string
+	references []string
+	fromRepo   string
+	labels     []string
+	milestone  string
+	state      string
+	projectID  string
+	set        []string
+	format     string
 }
 
 type addItemConfig struct {
 	tp     tableprinter.TablePrinter
 	client *api.GraphQLClient
 	opts   addItemOpts
-}
-
-type addProjectItemMutation struct {
-	CreateProjectItem struct {
-		ProjectV2Item queries.ProjectItem `graphql:"item"`
-	} `graphql:"addProjectV2ItemById(input:$input)"`
+	// currentRepo returns the owner and name of the repository #NUMBER references are resolved against
+	currentRepo func() (string, string, error)
+	// progress receives a line for each item added with --from-repo
+	progress io.Writer
 }
 
 func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *cobra.Command {
 	opts := addItemOpts{}
 	addItemCmd := &cobra.Command{
-		Short: "Add a pull request or an issue to a project",
-		Use:   "item-add [number]",
+		Short: "Add pull requests or issues to a project",
+		Use:   "item-add [number] [reference...]",
+		Long: `Add pull requests or issues to a project.
+
+The items are given by --url or as references, which can be URLs, OWNER/REPO#NUMBER, or #NUMBER
+for an issue or pull request of the repository of the current directory. Owner and repository
+names are not case sensitive.
+
+With --from-repo, the issues and pull requests of a repository that match --label, --milestone
+and --state are added instead. Items already in the project are skipped.`,
 		Example: `
 # add an item to the current user's project 1
 gh projects item-add 1 --user "@me" --url https://github.com/cli/go-gh/issues/1
@@ -52,21 +65,41 @@ gh projects item-add 1 --user monalisa --url https://github.com/cli/go-gh/issues
 # add an item to the org github's project 1
 gh projects item-add 1 --org github --url https://github.com/cli/go-gh/issues/1
 
+# add several items to the org github's project 1, the last one from the repository of the current directory
+gh projects item-add 1 --org github cli/go-gh#1 cli/cli#2 "#3"
+
+# add the open issues and pull requests labeled bug of cli/go-gh to the org github's project 1
+gh projects item-add 1 --org github --from-repo cli/go-gh --label bug
+
+# add an item to the org github's project 1 with its status and estimate set
+gh projects item-add 1 --org github --url https://github.com/cli/go-gh/issues/1 --set Status="In progress" --set Estimate=3
+
 # add --format=json to output in JSON format
 `,
-		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := queries.NewClient()
 			if err != nil {
 				return err
 			}
 
-			if len(args) == 1 {
-				opts.number, err = strconv.Atoi(args[0])
-				if err != nil {
-					return err
+			// the project number comes first, references are never plain numbers
+			if len(args) > 0 {
+				if number, err := strconv.Atoi(args[0]); err == nil {
+					opts.number = number
+					args = args[1:]
 				}
 			}
+			opts.references = args
+
+			if opts.fromRepo != "" {
+				if opts.itemURL != "" || len(opts.references) > 0 {
+					return errors.New("--from-repo cannot be used with --url or references")
+				}
+			} else if opts.itemURL == "" && len(opts.references) == 0 {
+				return errors.New("at least one of --url, --from-repo or a reference must be given")
+			} else if len(opts.labels) > 0 || opts.milestone != "" || cmd.Flags().Changed("state") {
+				return errors.New("--label, --milestone and --state require --from-repo")
+			}
 
 			terminal := term.FromEnv()
 			termWidth, _, err := terminal.Size()
@@ -76,10 +109,18 @@ gh projects item-add 1 --org github --url https://github.com/cli/go-gh/issues/1
 			}
 			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
 
+			// progress is only shown to people watching, it would get in the way of scripts
+			progress := io.Discard
+			if terminal.IsTerminalOutput() {
+				progress = terminal.ErrOut()
+			}
+
 			config := addItemConfig{
-				tp:     t,
-				client: client,
-				opts:   opts,
+				tp:          t,
+				client:      client,
+				opts:        opts,
+				currentRepo: currentRepo,
+				progress:    progress,
 			}
 			return runAddItem(config)
 		},
@@ -87,20 +128,50 @@ gh projects item-add 1 --org github --url https://github.com/cli/go-gh/issues/1
 
 	addItemCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
 	addItemCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
-	addItemCmd.Flags().StringVar(&opts.itemURL, "url", "", "URL of the issue or pull request to add to the project. Note that the name of the owner is case sensitive, and will fail to find the item if it does not match. Must be of form https://github.com/OWNER/REPO/issues/NUMBER or https://github.com/OWNER/REPO/pull/NUMBER")
+	addItemCmd.Flags().StringVar(&opts.itemURL, "url", "", "URL of the issue or pull request to add to the project. Must be of form https://github.com/OWNER/REPO/issues/NUMBER or https://github.com/OWNER/REPO/pull/NUMBER")
 	addItemCmd.MarkFlagsMutuallyExclusive("user", "org")
+	addItemCmd.Flags().StringVar(&opts.fromRepo, "from-repo", "", "Add the issues and pull requests of the repository `OWNER/REPO`.")
+	addItemCmd.Flags().StringArrayVar(&opts.labels, "label", nil, "With --from-repo, only add items with this label. Can be repeated to require several labels.")
+	addItemCmd.Flags().StringVar(&opts.milestone, "milestone", "", "With --from-repo, only add items in the milestone with this title.")
+	addItemCmd.Flags().StringVar(&opts.state, "state", "open", "With --from-repo, only add items in this state: {open|closed|all}.")
+	addItemCmd.Flags().StringArrayVar(&opts.set, "set", nil, "Set a field value of the item as `FIELD=VALUE`, such as Status=Done. Single select and iteration values are given by name. Can be repeated.")
 	addItemCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
 
-	_ = addItemCmd.MarkFlagRequired("url")
-
 	return addItemCmd
 }
 
+func currentRepo() (string, string, error) {
+	repo, err := repository.Current()
+	if err != nil {
+		return "", "", err
+	}
+	return repo.Owner, repo.Name, nil
+}
+
 func runAddItem(config addItemConfig) error {
 	if config.opts.format != "" && config.opts.format != "json" {
 		return fmt.Errorf("format must be 'json'")
 	}
 
+	if config.opts.fromRepo != "" {
+		return runAddFromRepo(config)
+	}
+
+	references := config.opts.references
+	if config.opts.itemURL != "" {
+		references = append([]string{config.opts.itemURL}, references...)
+	}
+
+	// the references are checked before any item is added, so that a typo does not leave some items out
+	parsed := make([]queries.ItemReference, 0, len(references))
+	for _, r := range references {
+		ref, err := queries.ParseItemReference(r, config.currentRepo)
+		if err != nil {
+			return err
+		}
+		parsed = append(parsed, ref)
+	}
+
 	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
 	if err != nil {
 		return err
@@ -112,45 +183,223 @@ func runAddItem(config addItemConfig) error {
 	}
 	config.opts.projectID = project.ID
 
-	itemID, err := queries.IssueOrPullRequestID(config.client, config.opts.itemURL)
+	// the values are checked before the item is added, so that a typo does not leave a half configured item
+	values, err := queries.ProjectFieldValues(config.client, project.ID, config.opts.set)
 	if err != nil {
 		return err
 	}
 
-	config.opts.itemID = itemID
+	if len(parsed) == 1 {
+		item, err := addItem(config, parsed[0], values)
+		if err != nil {
+			return err
+		}
+
+		if config.opts.format == "json" {
+			return printJSON(config, *item, values)
+		}
 
-	query, variables := addItemArgs(config)
-	err = config.client.Mutate("AddItem", query, variables)
+		return printResults(config, *item, values)
+	}
+
+	results := make([]format.ItemResult, 0, len(parsed))
+	failed := 0
+	for _, ref := range parsed {
+		item, err := addItem(config, ref, values)
+		if err != nil {
+			failed++
+		}
+		results = append(results, format.ItemResult{Reference: ref.String(), Item: item, Err: err})
+	}
+
+	if config.opts.format == "json" {
+		err = printResultsJSON(config, results, values)
+	} else {
+		err = printAddResults(config, results)
+	}
 	if err != nil {
 		return err
 	}
 
-	if config.opts.format == "json" {
-		return printJSON(config, query.CreateProjectItem.ProjectV2Item)
+	if failed > 0 {
+		return fmt.Errorf("could not add %d of %d items", failed, len(results))
 	}
+	return nil
+}
+
+// addItem adds the issue or pull request of a reference to the project and sets the field values on it.
+func addItem(config addItemConfig, ref queries.ItemReference, values []queries.FieldValueInput) (*queries.ProjectItem, error) {
+	content, err := queries.IssueOrPullRequest(config.client, ref)
+	if err != nil {
+		return nil, err
+	}
+	return addContent(config, content.ID, values)
+}
 
-	return printResults(config, query.CreateProjectItem.ProjectV2Item)
+// addContent adds the issue or pull request with the given ID to the project and sets the field values on it.
+func addContent(config addItemConfig, contentID string, values []queries.FieldValueInput) (*queries.ProjectItem, error) {
+	item, err := queries.AddProjectItem(config.client, config.opts.projectID, contentID)
+	if err != nil {
+		return nil, err
+	}
 
+	for _, v := range values {
+		_, err := queries.SetFieldValue(config.client, config.opts.projectID, item.ID(), v)
+		if err != nil {
+			return nil, fmt.Errorf("added item %s, but could not set %s: %w", item.ID(), v.Field.Name(), err)
+		}
+	}
+	return item, nil
 }
 
-func addItemArgs(config addItemConfig) (*addProjectItemMutation, map[string]interface{}) {
-	return &addProjectItemMutation{}, map[string]interface{}{
-		"input": githubv4.AddProjectV2ItemByIdInput{
-			ProjectID: githubv4.ID(config.opts.projectID),
-			ContentID: githubv4.ID(config.opts.itemID),
-		},
+// runAddFromRepo adds the issues and pull requests of --from-repo that match the filters and are not
+// already in the project. Each item is added even if another fails, and the failures are reported at the end.
+func runAddFromRepo(config addItemConfig) error {
+	if config.opts.state != "open" && config.opts.state != "closed" && config.opts.state != "all" {
+		return fmt.Errorf("state must be one of open, closed or all")
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
 	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	values, err := queries.ProjectFieldValues(config.client, project.ID, config.opts.set)
+	if err != nil {
+		return err
+	}
+
+	repoItems, err := queries.RepositoryItems(config.client, config.opts.fromRepo, queries.RepositoryItemsFilter{
+		Labels:    config.opts.labels,
+		Milestone: config.opts.milestone,
+		State:     config.opts.state,
+	})
+	if err != nil {
+		return err
+	}
+
+	existing, err := queries.ProjectItems(config.client, owner, project.Number, 0)
+	if err != nil {
+		return err
+	}
+	inProject := make(map[string]bool, len(existing.Items.Nodes))
+	for _, i := range existing.Items.Nodes {
+		if url := i.URL(); url != "" {
+			inProject[url] = true
+		}
+	}
+
+	results := make([]format.ItemResult, 0, len(repoItems))
+	titles := make([]string, 0, len(repoItems))
+	added, skipped, failed := 0, 0, 0
+	for n, i := range repoItems {
+		ref := i.Reference(config.opts.fromRepo)
+		titles = append(titles, i.Title)
+		if inProject[i.URL] {
+			skipped++
+			results = append(results, format.ItemResult{Reference: ref, Skipped: true})
+			continue
+		}
+
+		fmt.Fprintf(config.progress, "Adding %s (%d/%d)\n", ref, n+1, len(repoItems))
+		item, err := addContent(config, i.ID, values)
+		if err != nil {
+			failed++
+		} else {
+			added++
+		}
+		results = append(results, format.ItemResult{Reference: ref, Item: item, Err: err})
+	}
+
+	if config.opts.format == "json" {
+		err = printResultsJSON(config, results, values)
+	} else {
+		err = printFromRepoResults(config, results, titles, added, skipped, failed)
+	}
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("could not add %d of %d items", failed, added+failed)
+	}
+	return nil
 }
 
-func printResults(config addItemConfig, item queries.ProjectItem) error {
+func printResults(config addItemConfig, item queries.ProjectItem, values []queries.FieldValueInput) error {
 	// using table printer here for consistency in case it ends up being needed in the future
 	config.tp.AddField("Added item")
 	config.tp.EndRow()
+	for _, v := range values {
+		config.tp.AddField(fmt.Sprintf("%s: %s", v.Field.Name(), v.Text))
+		config.tp.EndRow()
+	}
+	return config.tp.Render()
+}
+
+func printAddResults(config addItemConfig, results []format.ItemResult) error {
+	for _, r := range results {
+		if r.Err != nil {
+			config.tp.AddField(fmt.Sprintf("Could not add %s: %s", r.Reference, r.Err))
+		} else {
+			config.tp.AddField(fmt.Sprintf("Added %s", r.Reference))
+		}
+		config.tp.EndRow()
+	}
+	return config.tp.Render()
+}
+
+func printFromRepoResults(config addItemConfig, results []format.ItemResult, titles []string, added int, skipped int, failed int) error {
+	if len(results) == 0 {
+		config.tp.AddField(fmt.Sprintf("No matching issues or pull requests in %s", config.opts.fromRepo))
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	config.tp.AddField("Item")
+	config.tp.AddField("Title")
+	config.tp.AddField("Result")
+	config.tp.EndRow()
+	for n, r := range results {
+		config.tp.AddField(r.Reference)
+		config.tp.AddField(titles[n])
+		switch {
+		case r.Err != nil:
+			config.tp.AddField(fmt.Sprintf("Failed: %s", r.Err))
+		case r.Skipped:
+			config.tp.AddField("Already in project")
+		default:
+			config.tp.AddField("Added")
+		}
+		config.tp.EndRow()
+	}
+
+	summary := fmt.Sprintf("Added %s, skipped %d already in the project", format.Pluralize(added, "item"), skipped)
+	if failed > 0 {
+		summary += fmt.Sprintf(", %d failed", failed)
+	}
+	config.tp.AddField(summary)
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+func printJSON(config addItemConfig, item queries.ProjectItem, values []queries.FieldValueInput) error {
+	b, err := format.JSONProjectItemWithValues(item, values)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
 	return config.tp.Render()
 }
 
-func printJSON(config addItemConfig, item queries.ProjectItem) error {
-	b, err := format.JSONProjectItem(item)
+func printResultsJSON(config addItemConfig, results []format.ItemResult, values []queries.FieldValueInput) error {
+	b, err := format.JSONItemResults(results, values)
 	if err != nil {
 		return err
 	}
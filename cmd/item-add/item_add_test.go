@@ -2,6 +2,7 @@ package itemadd
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
 	"github.com/cli/go-gh/v2/pkg/api"
@@ -64,17 +65,22 @@ func TestRunAddItem_User(t *testing.T) {
 		Post("/graphql").
 		MatchType("json").
 		JSON(map[string]interface{}{
-			"query": "query GetIssueOrPullRequest.*",
+			"query": "query IssueOrPullRequest.*",
 			"variables": map[string]interface{}{
-				"url": "https://github.com/cli/go-gh/issues/1",
+				"owner":  "cli",
+				"name":   "go-gh",
+				"number": 1,
 			},
 		}).
 		Reply(200).
 		JSON(map[string]interface{}{
 			"data": map[string]interface{}{
-				"resource": map[string]interface{}{
-					"id":         "item ID",
-					"__typename": "Issue",
+				"repository": map[string]interface{}{
+					"issueOrPullRequest": map[string]interface{}{
+						"id":         "item ID",
+						"__typename": "Issue",
+						"url":        "https://github.com/cli/go-gh/issues/1",
+					},
 				},
 			},
 		})
@@ -169,17 +175,22 @@ func TestRunAddItem_Org(t *testing.T) {
 		Post("/graphql").
 		MatchType("json").
 		JSON(map[string]interface{}{
-			"query": "query GetIssueOrPullRequest.*",
+			"query": "query IssueOrPullRequest.*",
 			"variables": map[string]interface{}{
-				"url": "https://github.com/cli/go-gh/issues/1",
+				"owner":  "cli",
+				"name":   "go-gh",
+				"number": 1,
 			},
 		}).
 		Reply(200).
 		JSON(map[string]interface{}{
 			"data": map[string]interface{}{
-				"resource": map[string]interface{}{
-					"id":         "item ID",
-					"__typename": "Issue",
+				"repository": map[string]interface{}{
+					"issueOrPullRequest": map[string]interface{}{
+						"id":         "item ID",
+						"__typename": "Issue",
+						"url":        "https://github.com/cli/go-gh/issues/1",
+					},
 				},
 			},
 		})
@@ -270,17 +281,22 @@ func TestRunAddItem_Me(t *testing.T) {
 		Post("/graphql").
 		MatchType("json").
 		JSON(map[string]interface{}{
-			"query": "query GetIssueOrPullRequest.*",
+			"query": "query IssueOrPullRequest.*",
 			"variables": map[string]interface{}{
-				"url": "https://github.com/cli/go-gh/pull/1",
+				"owner":  "cli",
+				"name":   "go-gh",
+				"number": 1,
 			},
 		}).
 		Reply(200).
 		JSON(map[string]interface{}{
 			"data": map[string]interface{}{
-				"resource": map[string]interface{}{
-					"id":         "item ID",
-					"__typename": "PullRequest",
+				"repository": map[string]interface{}{
+					"issueOrPullRequest": map[string]interface{}{
+						"id":         "item ID",
+						"__typename": "PullRequest",
+						"url":        "https://github.com/cli/go-gh/pull/1",
+					},
 				},
 			},
 		})
@@ -321,3 +337,621 @@ func TestRunAddItem_Me(t *testing.T) {
 		"Added item\n",
 		buf.String())
 }
+
+func TestRunAddItem_References(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// get the first item, whose owner casing differs
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query IssueOrPullRequest.*",
+			"variables": map[string]interface{}{
+				"owner":  "CLI",
+				"name":   "go-gh",
+				"number": 1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"issueOrPullRequest": map[string]interface{}{
+						"id":         "item ID",
+						"__typename": "Issue",
+						"url":        "https://github.com/cli/go-gh/issues/1",
+					},
+				},
+			},
+		})
+
+	// add the first item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"an ID","contentId":"item ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "project item ID",
+					},
+				},
+			},
+		})
+
+	// the second item, from the current repository, does not exist
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query IssueOrPullRequest.*",
+			"variables": map[string]interface{}{
+				"owner":  "cli",
+				"name":   "cli",
+				"number": 2,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"issueOrPullRequest": nil,
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := addItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: addItemOpts{
+			orgOwner:   "github",
+			number:     1,
+			references: []string{"CLI/go-gh#1", "#2"},
+		},
+		client: client,
+		currentRepo: func() (string, string, error) {
+			return "cli", "cli", nil
+		},
+	}
+
+	err = runAddItem(config)
+	assert.EqualError(t, err, "could not add 1 of 2 items")
+	assert.Equal(
+		t,
+		"Added CLI/go-gh#1\nCould not add cli/cli#2: no issue or pull request cli/cli#2 found\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunAddItem_FromRepo(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// list the first page of issues, the second issue lacks one of the labels
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"query RepositoryIssues.*","variables":{"after":null,"first":100,"labels":\["bug","p1"\],"name":"go-gh","owner":"cli","states":\["OPEN"\]}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"issues": map[string]interface{}{
+						"pageInfo": map[string]interface{}{
+							"hasNextPage": true,
+							"endCursor":   "cursor",
+						},
+						"nodes": []map[string]interface{}{
+							{
+								"id":     "issue 1",
+								"number": 1,
+								"title":  "crash on start",
+								"url":    "https://github.com/cli/go-gh/issues/1",
+								"labels": map[string]interface{}{
+									"nodes": []map[string]interface{}{{"name": "bug"}, {"name": "P1"}},
+								},
+							},
+							{
+								"id":     "issue 2",
+								"number": 2,
+								"title":  "typo in docs",
+								"url":    "https://github.com/cli/go-gh/issues/2",
+								"labels": map[string]interface{}{
+									"nodes": []map[string]interface{}{{"name": "bug"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// list the second page of issues
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"query RepositoryIssues.*","variables":{"after":"cursor","first":100,"labels":\["bug","p1"\],"name":"go-gh","owner":"cli","states":\["OPEN"\]}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"issues": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"id":     "issue 4",
+								"number": 4,
+								"title":  "slow startup",
+								"url":    "https://github.com/cli/go-gh/issues/4",
+								"labels": map[string]interface{}{
+									"nodes": []map[string]interface{}{{"name": "bug"}, {"name": "p1"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// list pull requests
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"query RepositoryPullRequests.*","variables":{"after":null,"first":100,"labels":\["bug","p1"\],"name":"go-gh","owner":"cli","states":\["OPEN"\]}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"pullRequests": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"id":     "pull request 3",
+								"number": 3,
+								"title":  "fix crash on start",
+								"url":    "https://github.com/cli/go-gh/pull/3",
+								"labels": map[string]interface{}{
+									"nodes": []map[string]interface{}{{"name": "bug"}, {"name": "p1"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// list project items, the pull request is already in the project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  100,
+				"afterItems":  nil,
+				"firstFields": 100,
+				"afterFields": nil,
+				"login":       "github",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "item 1",
+									"content": map[string]interface{}{
+										"__typename": "PullRequest",
+										"title":      "fix crash on start",
+										"url":        "https://github.com/cli/go-gh/pull/3",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// add the first issue
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"project ID","contentId":"issue 1"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item 2",
+					},
+				},
+			},
+		})
+
+	// adding the second issue fails
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"project ID","contentId":"issue 4"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"errors": []map[string]interface{}{
+				{"message": "project is full"},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	progress := bytes.Buffer{}
+	config := addItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: addItemOpts{
+			orgOwner: "github",
+			number:   1,
+			fromRepo: "cli/go-gh",
+			labels:   []string{"bug", "p1"},
+			state:    "open",
+		},
+		client:   client,
+		progress: &progress,
+	}
+
+	err = runAddItem(config)
+	assert.EqualError(t, err, "could not add 1 of 2 items")
+	assert.Equal(
+		t,
+		"Item\tTitle\tResult\ncli/go-gh#1\tcrash on start\tAdded\ncli/go-gh#4\tslow startup\tFailed: GraphQL: project is full\ncli/go-gh#3\tfix crash on start\tAlready in project\nAdded 1 item, skipped 1 already in the project, 1 failed\n",
+		buf.String())
+	assert.Equal(t, "Adding cli/go-gh#1 (1/3)\nAdding cli/go-gh#4 (2/3)\n", progress.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunAddItem_FromRepoInvalidState(t *testing.T) {
+	config := addItemConfig{
+		opts: addItemOpts{
+			orgOwner: "github",
+			number:   1,
+			fromRepo: "cli/go-gh",
+			state:    "merged",
+		},
+	}
+
+	err := runAddItem(config)
+	assert.EqualError(t, err, "state must be one of open, closed or all")
+}
+
+// mockFromRepoProject mocks the org github's project 1, which holds pull request 3 of cli/go-gh.
+func mockFromRepoProject() {
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  100,
+				"afterItems":  nil,
+				"firstFields": 100,
+				"afterFields": nil,
+				"login":       "github",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "item 1",
+									"content": map[string]interface{}{
+										"__typename": "PullRequest",
+										"title":      "fix crash on start",
+										"url":        "https://github.com/cli/go-gh/pull/3",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestRunAddItem_FromRepoNoLabels(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockFromRepoProject()
+
+	// without --label, labels is an optional argument sent as null
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"query RepositoryIssues\(\$after:String\$first:Int!\$labels:\[String!\]\$name:String!\$owner:String!\$states:\[IssueState!\]\).*","variables":{"after":null,"first":100,"labels":null,"name":"go-gh","owner":"cli","states":\["OPEN"\]}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"issues": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"id":     "issue 1",
+								"number": 1,
+								"title":  "crash on start",
+								"url":    "https://github.com/cli/go-gh/issues/1",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"query RepositoryPullRequests\(\$after:String\$first:Int!\$labels:\[String!\]\$name:String!\$owner:String!\$states:\[PullRequestState!\]\).*","variables":{"after":null,"first":100,"labels":null,"name":"go-gh","owner":"cli","states":\["OPEN"\]}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"pullRequests": map[string]interface{}{
+						"nodes": []map[string]interface{}{},
+					},
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"project ID","contentId":"issue 1"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item 2",
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := addItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: addItemOpts{
+			orgOwner: "github",
+			number:   1,
+			fromRepo: "cli/go-gh",
+			state:    "open",
+		},
+		client:   client,
+		progress: io.Discard,
+	}
+
+	err = runAddItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Item\tTitle\tResult\ncli/go-gh#1\tcrash on start\tAdded\nAdded 1 item, skipped 0 already in the project\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunAddItem_FromRepoAllStates(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockFromRepoProject()
+
+	// with --state all, states is an optional argument sent as null
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"query RepositoryIssues\(\$after:String\$first:Int!\$labels:\[String!\]\$name:String!\$owner:String!\$states:\[IssueState!\]\).*","variables":{"after":null,"first":100,"labels":\["bug"\],"name":"go-gh","owner":"cli","states":null}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"issues": map[string]interface{}{
+						"nodes": []map[string]interface{}{},
+					},
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"query RepositoryPullRequests\(\$after:String\$first:Int!\$labels:\[String!\]\$name:String!\$owner:String!\$states:\[PullRequestState!\]\).*","variables":{"after":null,"first":100,"labels":\["bug"\],"name":"go-gh","owner":"cli","states":null}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"pullRequests": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"id":     "pull request 3",
+								"number": 3,
+								"title":  "fix crash on start",
+								"url":    "https://github.com/cli/go-gh/pull/3",
+								"state":  "MERGED",
+								"labels": map[string]interface{}{
+									"nodes": []map[string]interface{}{{"name": "bug"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := addItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: addItemOpts{
+			orgOwner: "github",
+			number:   1,
+			fromRepo: "cli/go-gh",
+			labels:   []string{"bug"},
+			state:    "all",
+		},
+		client:   client,
+		progress: io.Discard,
+	}
+
+	err = runAddItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Item\tTitle\tResult\ncli/go-gh#3\tfix crash on start\tAlready in project\nAdded 0 items, skipped 1 already in the project\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
@@ -3,12 +3,14 @@ package itemarchive
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cli/cli/v2/pkg/cmdutil"
 
 	"github.com/cli/go-gh/v2/pkg/api"
 	"github.com/cli/go-gh/v2/pkg/tableprinter"
 	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/filter"
 	"github.com/github/gh-projects/format"
 	"github.com/github/gh-projects/queries"
 	"github.com/shurcooL/githubv4"
@@ -23,6 +25,9 @@ type archiveItemOpts struct {
 	itemID    string
 	projectID string
 	format    string
+	query     string
+	dryRun    bool
+	yes       bool
 }
 
 type archiveItemConfig struct {
@@ -61,6 +66,15 @@ gh projects item-archive 1 --org github --id ID
 # unarchive an item
 gh projects item-archive 1 --user "@me" --id ID --undo
 
+# archive all the done items of org github's project 1 not updated since September 1st
+gh projects item-archive 1 --org github --query "status:Done updated:<2026-09-01"
+
+# list the items that would be unarchived, without unarchiving them
+gh projects item-archive 1 --org github --query "is:archived label:bug" --undo --dry-run
+
+# select the item to archive interactively
+gh projects item-archive 1 --org github
+
 # add --format=json to output in JSON format
 `,
 		Args: cobra.MaximumNArgs(1),
@@ -96,12 +110,15 @@ gh projects item-archive 1 --user "@me" --id ID --undo
 
 	archiveItemCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
 	archiveItemCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
-	archiveItemCmd.Flags().StringVar(&opts.itemID, "id", "", "Global ID of the item to archive from the project.")
+	archiveItemCmd.Flags().StringVar(&opts.itemID, "id", "", "Global ID of the item to archive from the project. If omitted, the item is selected interactively.")
 	archiveItemCmd.Flags().BoolVar(&opts.undo, "undo", false, "Undo archive (unarchive) of an item.")
 	archiveItemCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+	archiveItemCmd.Flags().StringVar(&opts.query, "query", "", "Archive all the items matching a filter, such as \"status:Done updated:<2023-01-01\".")
+	archiveItemCmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the items matching --query without archiving them.")
+	archiveItemCmd.Flags().BoolVar(&opts.yes, "yes", false, "Archive the items matching --query without confirmation.")
 
 	archiveItemCmd.MarkFlagsMutuallyExclusive("user", "org")
-	_ = archiveItemCmd.MarkFlagRequired("id")
+	archiveItemCmd.MarkFlagsMutuallyExclusive("id", "query")
 
 	return archiveItemCmd
 }
@@ -122,6 +139,18 @@ func runArchiveItem(config archiveItemConfig) error {
 	}
 	config.opts.projectID = project.ID
 
+	if config.opts.query != "" {
+		return runArchiveQuery(config, owner, project)
+	}
+
+	if config.opts.itemID == "" {
+		item, err := queries.NewItem(config.client, owner, project.Number)
+		if err != nil {
+			return err
+		}
+		config.opts.itemID = item.ID()
+	}
+
 	if config.opts.undo {
 		query, variables := unarchiveItemArgs(config, config.opts.itemID)
 		err = config.client.Mutate("UnarchiveProjectItem", query, variables)
@@ -148,6 +177,74 @@ func runArchiveItem(config archiveItemConfig) error {
 	return printResults(config, query.ArchiveProjectItem.ProjectV2Item)
 }
 
+// runArchiveQuery archives, or unarchives with --undo, all the items matching the --query filter
+// after confirmation.
+func runArchiveQuery(config archiveItemConfig, owner *queries.Owner, project *queries.Project) error {
+	f, err := filter.Parse(config.opts.query)
+	if err != nil {
+		return err
+	}
+
+	p, err := queries.ProjectItems(config.client, owner, project.Number, 0)
+	if err != nil {
+		return err
+	}
+
+	// only the items that are not yet archived, or unarchived, are affected
+	items := make([]queries.ProjectItem, 0)
+	for _, i := range f.Items(p.Items.Nodes) {
+		if i.IsArchived == config.opts.undo {
+			items = append(items, i)
+		}
+	}
+
+	verb, done, mutation, name := "Archive", "Archived", "archiveProjectV2Item", "ArchiveProjectItems"
+	if config.opts.undo {
+		verb, done, mutation, name = "Unarchive", "Unarchived", "unarchiveProjectV2Item", "UnarchiveProjectItems"
+	}
+
+	if config.opts.format == "json" && (len(items) == 0 || config.opts.dryRun) {
+		return printBulkJSON(config, strings.ToLower(done), items)
+	}
+
+	if len(items) == 0 {
+		config.tp.AddField(fmt.Sprintf("No items to %s match the query", strings.ToLower(verb)))
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	if config.opts.dryRun {
+		return format.PrintItems(config.tp, items)
+	}
+
+	if !config.opts.yes {
+		ok, err := queries.Confirm(fmt.Sprintf("%s %s matching %q?", verb, format.Pluralize(len(items), "item"), config.opts.query), "use --yes to confirm")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, i := range items {
+		ids = append(ids, i.ID())
+	}
+	n, err := queries.BulkItemMutation(config.client, name, mutation, project.ID, ids)
+	if err != nil {
+		return fmt.Errorf("%s %d of %d items: %w", strings.ToLower(done), n, len(ids), err)
+	}
+
+	if config.opts.format == "json" {
+		return printBulkJSON(config, strings.ToLower(done), items)
+	}
+
+	config.tp.AddField(fmt.Sprintf("%s %s", done, format.Pluralize(n, "item")))
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
 func archiveItemArgs(config archiveItemConfig) (*archiveProjectItemMutation, map[string]interface{}) {
 	return &archiveProjectItemMutation{}, map[string]interface{}{
 		"input": githubv4.ArchiveProjectV2ItemInput{
@@ -185,3 +282,12 @@ func printJSON(config archiveItemConfig, item queries.ProjectItem) error {
 	config.tp.AddField(string(b))
 	return config.tp.Render()
 }
+
+func printBulkJSON(config archiveItemConfig, action string, items []queries.ProjectItem) error {
+	b, err := format.JSONBulkItems(action, config.opts.dryRun, items)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
@@ -515,3 +515,141 @@ func TestRunArchive_Me_Undo(t *testing.T) {
 		"Unarchived item\n",
 		buf.String())
 }
+
+func TestRunArchive_Query(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "an ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  100,
+				"afterItems":  nil,
+				"firstFields": 100,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "item 1",
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "plan release",
+									},
+								},
+								{
+									"id":         "item 2",
+									"isArchived": true,
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "ship release",
+									},
+								},
+								{
+									"id": "item 3",
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "write docs",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// archive the matching items that are not archived yet
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation ArchiveProjectItems.*item0: archiveProjectV2Item.*","variables":{"item0":"item 1","projectId":"an ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"item0": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := archiveItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: archiveItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			query:     "release",
+			yes:       true,
+		},
+		client: client,
+	}
+
+	err = runArchiveItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Archived 1 item\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
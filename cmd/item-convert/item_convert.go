@@ -0,0 +1,274 @@
+package itemconvert
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/filter"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type convertItemOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	itemID    string
+	repo      string
+	query     string
+	dryRun    bool
+	yes       bool
+	format    string
+}
+
+type convertItemConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   convertItemOpts
+}
+
+// ConvertProjectV2DraftIssueItemToIssueInput is an input type of ConvertProjectV2DraftIssueItemToIssue.
+type ConvertProjectV2DraftIssueItemToIssueInput struct {
+	ItemID       githubv4.ID `json:"itemId"`
+	RepositoryID githubv4.ID `json:"repositoryId"`
+}
+
+type convertProjectItemMutation struct {
+	ConvertProjectItem struct {
+		ProjectV2Item queries.ProjectItem `graphql:"item"`
+	} `graphql:"convertProjectV2DraftIssueItemToIssue(input:$input)"`
+}
+
+func NewCmdConvertItem(f *cmdutil.Factory, runF func(config convertItemConfig) error) *cobra.Command {
+	opts := convertItemOpts{}
+	convertItemCmd := &cobra.Command{
+		Short: "Convert a draft issue in a project to an issue",
+		Long:  "Convert a draft issue in a project to an issue in a repository. The item keeps its project field values.",
+		Use:   "item-convert [number]",
+		Example: `
+# convert a draft issue to an issue in the cli/go-gh repository
+gh projects item-convert --id ID --repo cli/go-gh
+
+# convert all the draft issues of org github's project 1 with the status Ready
+gh projects item-convert 1 --org github --repo cli/go-gh --query "status:Ready"
+
+# list the draft issues that would be converted, without converting them
+gh projects item-convert 1 --org github --repo cli/go-gh --query "label:bug" --dry-run
+
+# select the draft issue to convert interactively
+gh projects item-convert 1 --org github --repo cli/go-gh
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+			config := convertItemConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runConvertItem(config)
+		},
+	}
+
+	convertItemCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	convertItemCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	convertItemCmd.Flags().StringVar(&opts.itemID, "id", "", "ID of the draft issue item to convert. If omitted, the item is selected interactively.")
+	convertItemCmd.Flags().StringVar(&opts.repo, "repo", "", "Repository to create the issue in, of the form OWNER/REPO.")
+	convertItemCmd.Flags().StringVar(&opts.query, "query", "", "Convert all the draft issues matching a filter, such as \"status:Ready label:bug\".")
+	convertItemCmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the draft issues matching --query without converting them.")
+	convertItemCmd.Flags().BoolVar(&opts.yes, "yes", false, "Convert the draft issues matching --query without confirmation.")
+	convertItemCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	// owner can be a user or an org
+	convertItemCmd.MarkFlagsMutuallyExclusive("user", "org")
+	convertItemCmd.MarkFlagsMutuallyExclusive("id", "query")
+	convertItemCmd.MarkFlagsMutuallyExclusive("format", "query")
+
+	_ = convertItemCmd.MarkFlagRequired("repo")
+
+	return convertItemCmd
+}
+
+func runConvertItem(config convertItemConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	repo, err := queries.NewRepository(config.client, config.opts.repo)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.itemID == "" {
+		owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+		if err != nil {
+			return err
+		}
+
+		project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+		if err != nil {
+			return err
+		}
+
+		if config.opts.query == "" && !queries.CanPrompt() {
+			return queries.NoPromptError("use --id or --query to select the draft issues")
+		}
+
+		p, err := queries.ProjectItems(config.client, owner, project.Number, 0)
+		if err != nil {
+			return err
+		}
+
+		if config.opts.query != "" {
+			return runConvertQuery(config, repo, p.Items.Nodes)
+		}
+
+		item, err := queries.SelectItem(draftIssues(p.Items.Nodes))
+		if err != nil {
+			return err
+		}
+		config.opts.itemID = item.ID()
+	}
+
+	item, err := convertItem(config, config.opts.itemID, repo.ID)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config, *item)
+	}
+
+	return printResults(config, []queries.ProjectItem{*item})
+}
+
+// runConvertQuery converts all the draft issues matching the --query filter after confirmation.
+// Items other than draft issues are ignored.
+func runConvertQuery(config convertItemConfig, repo *queries.Repository, items []queries.ProjectItem) error {
+	f, err := filter.Parse(config.opts.query)
+	if err != nil {
+		return err
+	}
+
+	drafts := f.Items(draftIssues(items))
+	if len(drafts) == 0 {
+		config.tp.AddField("No draft issues to convert match the query")
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	if config.opts.dryRun {
+		return printDrafts(config, drafts)
+	}
+
+	if !config.opts.yes {
+		ok, err := queries.Confirm(fmt.Sprintf("Convert %s matching %q to issues in %s?", format.Pluralize(len(drafts), "draft issue"), config.opts.query, repo.NameWithOwner), "use --yes to confirm")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	converted := make([]queries.ProjectItem, 0, len(drafts))
+	for _, d := range drafts {
+		item, err := convertItem(config, d.ID(), repo.ID)
+		if err != nil {
+			// print the issues created so far, so they are not lost
+			_ = printResults(config, converted)
+			return fmt.Errorf("converted %d of %d draft issues: %w", len(converted), len(drafts), err)
+		}
+		converted = append(converted, *item)
+	}
+
+	return printResults(config, converted)
+}
+
+// draftIssues returns the draft issues of items.
+func draftIssues(items []queries.ProjectItem) []queries.ProjectItem {
+	drafts := make([]queries.ProjectItem, 0)
+	for _, i := range items {
+		if i.Type() == "DraftIssue" {
+			drafts = append(drafts, i)
+		}
+	}
+	return drafts
+}
+
+func convertItem(config convertItemConfig, itemID string, repositoryID string) (*queries.ProjectItem, error) {
+	query, variables := convertItemArgs(itemID, repositoryID)
+	err := config.client.Mutate("ConvertProjectItem", query, variables)
+	if err != nil {
+		return nil, err
+	}
+	return &query.ConvertProjectItem.ProjectV2Item, nil
+}
+
+func convertItemArgs(itemID string, repositoryID string) (*convertProjectItemMutation, map[string]interface{}) {
+	return &convertProjectItemMutation{}, map[string]interface{}{
+		"input": ConvertProjectV2DraftIssueItemToIssueInput{
+			ItemID:       githubv4.ID(itemID),
+			RepositoryID: githubv4.ID(repositoryID),
+		},
+	}
+}
+
+func printResults(config convertItemConfig, items []queries.ProjectItem) error {
+	// the issue URLs are printed on their own, so they can be piped to other commands
+	for _, i := range items {
+		config.tp.AddField(i.URL())
+		config.tp.EndRow()
+	}
+	return config.tp.Render()
+}
+
+func printDrafts(config convertItemConfig, items []queries.ProjectItem) error {
+	config.tp.AddField("Title")
+	config.tp.AddField("ID")
+	config.tp.EndRow()
+
+	for _, i := range items {
+		config.tp.AddField(i.Title())
+		config.tp.AddField(i.ID())
+		config.tp.EndRow()
+	}
+
+	return config.tp.Render()
+}
+
+func printJSON(config convertItemConfig, item queries.ProjectItem) error {
+	b, err := format.JSONProjectItem(item)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
@@ -0,0 +1,244 @@
+package itemconvert
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRunConvert_ID(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get repository ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query Repository.*",
+			"variables": map[string]interface{}{
+				"owner": "cli",
+				"name":  "go-gh",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"id":            "repo ID",
+					"nameWithOwner": "cli/go-gh",
+				},
+			},
+		})
+
+	// convert item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation ConvertProjectItem.*","variables":{"input":{"itemId":"item ID","repositoryId":"repo ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"convertProjectV2DraftIssueItemToIssue": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item ID",
+						"content": map[string]interface{}{
+							"__typename": "Issue",
+							"title":      "a title",
+							"url":        "https://github.com/cli/go-gh/issues/1",
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := convertItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: convertItemOpts{
+			itemID: "item ID",
+			repo:   "cli/go-gh",
+		},
+		client: client,
+	}
+
+	err = runConvertItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"https://github.com/cli/go-gh/issues/1\n",
+		buf.String())
+}
+
+func TestRunConvert_Query(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get repository ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query Repository.*",
+			"variables": map[string]interface{}{
+				"owner": "cli",
+				"name":  "go-gh",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"id":            "repo ID",
+					"nameWithOwner": "cli/go-gh",
+				},
+			},
+		})
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  100,
+				"afterItems":  nil,
+				"firstFields": 100,
+				"afterFields": nil,
+				"login":       "github",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "item 1",
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "plan release",
+									},
+								},
+								{
+									"id": "item 2",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "release notes",
+										"url":        "https://github.com/cli/go-gh/issues/2",
+									},
+								},
+								{
+									"id": "item 3",
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "write docs",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// convert the matching draft issue only
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation ConvertProjectItem.*","variables":{"input":{"itemId":"item 1","repositoryId":"repo ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"convertProjectV2DraftIssueItemToIssue": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item 1",
+						"content": map[string]interface{}{
+							"__typename": "Issue",
+							"title":      "plan release",
+							"url":        "https://github.com/cli/go-gh/issues/3",
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := convertItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: convertItemOpts{
+			orgOwner: "github",
+			number:   1,
+			repo:     "cli/go-gh",
+			query:    "release",
+			yes:      true,
+		},
+		client: client,
+	}
+
+	err = runConvertItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"https://github.com/cli/go-gh/issues/3\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
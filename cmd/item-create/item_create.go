@@ -1,7 +1,9 @@
 package itemcreate
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -9,6 +11,7 @@ import (
 	"github.com/cli/go-gh/v2/pkg/api"
 	"github.com/cli/go-gh/v2/pkg/tableprinter"
 	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/draft"
 	"github.com/github/gh-projects/format"
 	"github.com/github/gh-projects/queries"
 	"github.com/shurcooL/githubv4"
@@ -18,10 +21,17 @@ import (
 type createItemOpts struct {
 	title     string
 	body      string
+	bodyFile  string
+	editor    bool
+	assignees []string
+	repo      string
+	labels    []string
+	milestone string
 	userOwner string
 	orgOwner  string
 	number    int
 	projectID string
+	set       []string
 	format    string
 }
 
@@ -37,10 +47,20 @@ type createProjectDraftItemMutation struct {
 	} `graphql:"addProjectV2DraftIssue(input:$input)"`
 }
 
+type createIssueMutation struct {
+	CreateIssue struct {
+		Issue struct {
+			ID  string
+			URL string
+		}
+	} `graphql:"createIssue(input:$input)"`
+}
+
 func NewCmdCreateItem(f *cmdutil.Factory, runF func(config createItemConfig) error) *cobra.Command {
 	opts := createItemOpts{}
 	createItemCmd := &cobra.Command{
 		Short: "Create a draft issue item in a project",
+		Long:  "Create a draft issue item in a project, or with --repo, create an issue in a repository and add it to the project.",
 		Use:   "item-create [number]",
 		Example: `
 # create a draft issue in the current user's project 1 with title "new item" and body "new item body"
@@ -52,6 +72,18 @@ gh projects item-create 1 --user monalisa --title "new item" --body "new item bo
 # create a draft issue in org github's project 1 with title "new item" and body "new item body"
 gh projects item-create 1 --org github --title "new item" --body "new item body"
 
+# create a draft issue in org github's project 1 with the body read from a file and assigned to the current user
+gh projects item-create 1 --org github --title "new item" --body-file notes.md --assignee "@me"
+
+# write the title and body of a draft issue in org github's project 1 in your editor
+gh projects item-create 1 --org github --editor
+
+# create an issue in the cli/go-gh repository and add it to org github's project 1
+gh projects item-create 1 --org github --repo cli/go-gh --title "new issue" --label bug --milestone v2.0 --assignee monalisa
+
+# create a draft issue in org github's project 1 with its status, iteration and estimate set
+gh projects item-create 1 --org github --title "new item" --set Status=Todo --set Iteration="Iteration 3" --set Estimate=2
+
 # add --format=json to output in JSON format
 `,
 		Args: cobra.MaximumNArgs(1),
@@ -76,6 +108,28 @@ gh projects item-create 1 --org github --title "new item" --body "new item body"
 			}
 			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
 
+			if opts.bodyFile != "" {
+				opts.body, err = draft.ReadBody(opts.bodyFile, os.Stdin)
+				if err != nil {
+					return err
+				}
+			}
+
+			if opts.editor {
+				editorCommand, err := cmdutil.DetermineEditor(f.Config)
+				if err != nil {
+					return err
+				}
+				opts.title, opts.body, err = draft.Edit(editorCommand, opts.title, opts.body, os.Stdin, os.Stdout, os.Stderr)
+				if err != nil {
+					return err
+				}
+			}
+
+			if opts.title == "" {
+				return errors.New("title must be provided with --title or --editor")
+			}
+
 			config := createItemConfig{
 				tp:     t,
 				client: client,
@@ -89,10 +143,17 @@ gh projects item-create 1 --org github --title "new item" --body "new item body"
 	createItemCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
 	createItemCmd.Flags().StringVar(&opts.title, "title", "", "Title of the draft issue item.")
 	createItemCmd.Flags().StringVar(&opts.body, "body", "", "Body of the draft issue item.")
+	createItemCmd.Flags().StringVar(&opts.bodyFile, "body-file", "", "Read the body of the draft issue item from a file. Use \"-\" to read from standard input.")
+	createItemCmd.Flags().BoolVar(&opts.editor, "editor", false, "Write the title and body of the draft issue item in a text editor, starting from --title and --body.")
+	createItemCmd.Flags().StringSliceVar(&opts.assignees, "assignee", nil, "Logins of the users to assign to the item. Use \"@me\" for the current user.")
+	createItemCmd.Flags().StringVar(&opts.repo, "repo", "", "Create an issue in the repository, of the form OWNER/REPO, instead of a draft issue.")
+	createItemCmd.Flags().StringSliceVar(&opts.labels, "label", nil, "Names of the labels to add to the issue created with --repo.")
+	createItemCmd.Flags().StringVar(&opts.milestone, "milestone", "", "Title of the milestone to add the issue created with --repo to.")
+	createItemCmd.Flags().StringArrayVar(&opts.set, "set", nil, "Set a field value of the item as `FIELD=VALUE`, such as Status=Done. Single select and iteration values are given by name. Can be repeated.")
 	createItemCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
 
 	createItemCmd.MarkFlagsMutuallyExclusive("user", "org")
-	_ = createItemCmd.MarkFlagRequired("title")
+	createItemCmd.MarkFlagsMutuallyExclusive("body", "body-file")
 
 	return createItemCmd
 }
@@ -102,6 +163,10 @@ func runCreateItem(config createItemConfig) error {
 		return fmt.Errorf("format must be 'json'")
 	}
 
+	if config.opts.repo == "" && (len(config.opts.labels) > 0 || config.opts.milestone != "") {
+		return errors.New("--label and --milestone require --repo")
+	}
+
 	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
 	if err != nil {
 		return err
@@ -113,39 +178,144 @@ func runCreateItem(config createItemConfig) error {
 	}
 	config.opts.projectID = project.ID
 
-	query, variables := createDraftIssueArgs(config)
+	// the values are checked before the item is created, so that a typo does not leave a half configured item
+	values, err := queries.ProjectFieldValues(config.client, project.ID, config.opts.set)
+	if err != nil {
+		return err
+	}
+
+	assigneeIDs, err := queries.UserIDs(config.client, config.opts.assignees)
+	if err != nil {
+		return err
+	}
 
-	err = config.client.Mutate("CreateDraftItem", query, variables)
+	var item *queries.ProjectItem
+	if config.opts.repo != "" {
+		item, err = createIssueItem(config, project.Number, assigneeIDs)
+	} else {
+		item, err = createDraftItem(config, assigneeIDs)
+	}
 	if err != nil {
 		return err
 	}
 
+	for _, v := range values {
+		_, err := queries.SetFieldValue(config.client, config.opts.projectID, item.ID(), v)
+		if err != nil {
+			return fmt.Errorf("created item %s, but could not set %s: %w", item.ID(), v.Field.Name(), err)
+		}
+	}
+
 	if config.opts.format == "json" {
-		return printJSON(config, query.CreateProjectDraftItem.ProjectV2Item)
+		return printJSON(config, *item, values)
 	}
 
-	return printResults(config, query.CreateProjectDraftItem.ProjectV2Item)
+	return printResults(config, *item, values)
+}
+
+func createDraftItem(config createItemConfig, assigneeIDs []string) (*queries.ProjectItem, error) {
+	query, variables := createDraftIssueArgs(config, assigneeIDs)
+	err := config.client.Mutate("CreateDraftItem", query, variables)
+	if err != nil {
+		return nil, err
+	}
+	return &query.CreateProjectDraftItem.ProjectV2Item, nil
 }
 
-func createDraftIssueArgs(config createItemConfig) (*createProjectDraftItemMutation, map[string]interface{}) {
+// createIssueItem creates an issue in the --repo repository and adds it to the project. The issue is not
+// deleted if it cannot be added, as deleting issues requires admin access; the error tells how to add it instead.
+func createIssueItem(config createItemConfig, projectNumber int, assigneeIDs []string) (*queries.ProjectItem, error) {
+	repo, err := queries.NewRepository(config.client, config.opts.repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var labelIDs []string
+	var milestoneID string
+	if len(config.opts.labels) > 0 || config.opts.milestone != "" {
+		metadata, err := queries.NewRepositoryMetadata(config.client, repo.NameWithOwner)
+		if err != nil {
+			return nil, err
+		}
+		labelIDs, err = metadata.LabelIDs(config.opts.labels)
+		if err != nil {
+			return nil, err
+		}
+		if config.opts.milestone != "" {
+			milestoneID, err = metadata.MilestoneID(config.opts.milestone)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	createQuery, createVariables := createIssueArgs(config, repo.ID, assigneeIDs, labelIDs, milestoneID)
+	err = config.client.Mutate("CreateIssue", createQuery, createVariables)
+	if err != nil {
+		return nil, err
+	}
+	issue := createQuery.CreateIssue.Issue
+
+	item, err := queries.AddProjectItem(config.client, config.opts.projectID, issue.ID)
+	if err != nil {
+		return nil, fmt.Errorf("created issue %s, but could not add it to project %d, add it with `gh projects item-add %d --url %s`: %w", issue.URL, projectNumber, projectNumber, issue.URL, err)
+	}
+	return item, nil
+}
+
+func createDraftIssueArgs(config createItemConfig, assigneeIDs []string) (*createProjectDraftItemMutation, map[string]interface{}) {
+	input := githubv4.AddProjectV2DraftIssueInput{
+		Body:      githubv4.NewString(githubv4.String(config.opts.body)),
+		ProjectID: githubv4.ID(config.opts.projectID),
+		Title:     githubv4.String(config.opts.title),
+	}
+	input.AssigneeIDs = ids(assigneeIDs)
 	return &createProjectDraftItemMutation{}, map[string]interface{}{
-		"input": githubv4.AddProjectV2DraftIssueInput{
-			Body:      githubv4.NewString(githubv4.String(config.opts.body)),
-			ProjectID: githubv4.ID(config.opts.projectID),
-			Title:     githubv4.String(config.opts.title),
-		},
+		"input": input,
 	}
 }
 
-func printResults(config createItemConfig, item queries.ProjectItem) error {
+func createIssueArgs(config createItemConfig, repositoryID string, assigneeIDs []string, labelIDs []string, milestoneID string) (*createIssueMutation, map[string]interface{}) {
+	input := githubv4.CreateIssueInput{
+		RepositoryID: githubv4.ID(repositoryID),
+		Title:        githubv4.String(config.opts.title),
+		Body:         githubv4.NewString(githubv4.String(config.opts.body)),
+		AssigneeIDs:  ids(assigneeIDs),
+		LabelIDs:     ids(labelIDs),
+	}
+	if milestoneID != "" {
+		input.MilestoneID = githubv4.NewID(githubv4.ID(milestoneID))
+	}
+	return &createIssueMutation{}, map[string]interface{}{
+		"input": input,
+	}
+}
+
+// ids converts IDs for a mutation input, where no IDs are left out rather than sent empty.
+func ids(values []string) *[]githubv4.ID {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make([]githubv4.ID, 0, len(values))
+	for _, v := range values {
+		result = append(result, githubv4.ID(v))
+	}
+	return &result
+}
+
+func printResults(config createItemConfig, item queries.ProjectItem, values []queries.FieldValueInput) error {
 	// using table printer here for consistency in case it ends up being needed in the future
 	config.tp.AddField("Created item")
 	config.tp.EndRow()
+	for _, v := range values {
+		config.tp.AddField(fmt.Sprintf("%s: %s", v.Field.Name(), v.Text))
+		config.tp.EndRow()
+	}
 	return config.tp.Render()
 }
 
-func printJSON(config createItemConfig, item queries.ProjectItem) error {
-	b, err := format.JSONProjectItem(item)
+func printJSON(config createItemConfig, item queries.ProjectItem, values []queries.FieldValueInput) error {
+	b, err := format.JSONProjectItemWithValues(item, values)
 	if err != nil {
 		return err
 	}
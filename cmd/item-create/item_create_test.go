@@ -261,3 +261,474 @@ func TestRunCreateItem_Draft_Me(t *testing.T) {
 		"Created item\n",
 		buf.String())
 }
+
+func TestRunCreateItem_SetFields(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// get fields
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectFieldsWithDetails.*",
+			"variables": map[string]interface{}{
+				"id":    "an ID",
+				"first": 100,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"fields": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"__typename": "ProjectV2SingleSelectField",
+								"name":       "Status",
+								"id":         "status ID",
+								"dataType":   "SINGLE_SELECT",
+								"options": []map[string]interface{}{
+									{"id": "1", "name": "Todo"},
+									{"id": "2", "name": "Done"},
+								},
+							},
+							{
+								"__typename": "ProjectV2Field",
+								"name":       "Estimate",
+								"id":         "estimate ID",
+								"dataType":   "NUMBER",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// create item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateDraftItem.*","variables":{"input":{"projectId":"an ID","title":"a title","body":""}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2DraftIssue": map[string]interface{}{
+					"projectItem": map[string]interface{}{
+						"id": "item ID",
+						"content": map[string]interface{}{
+							"__typename": "DraftIssue",
+							"title":      "a title",
+						},
+					},
+				},
+			},
+		})
+
+	// set field values
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemFieldValue.*","variables":{"input":{"projectId":"an ID","itemId":"item ID","fieldId":"status ID","value":{"singleSelectOptionId":"2"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemFieldValue.*","variables":{"input":{"projectId":"an ID","itemId":"item ID","fieldId":"estimate ID","value":{"number":3}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := createItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: createItemOpts{
+			title:    "a title",
+			orgOwner: "github",
+			number:   1,
+			set:      []string{"Status=done", "estimate=3"},
+			format:   "json",
+		},
+		client: client,
+	}
+
+	err = runCreateItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"id":"item ID","title":"a title","body":"","type":"DraftIssue","isArchived":false,"fields":{"Estimate":"3","Status":"Done"}}`,
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunCreateItem_SetUnknownField(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// get fields, no item is created afterwards
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectFieldsWithDetails.*",
+			"variables": map[string]interface{}{
+				"id":    "an ID",
+				"first": 100,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"fields": map[string]interface{}{
+						"nodes": []map[string]interface{}{},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := createItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: createItemOpts{
+			title:    "a title",
+			orgOwner: "github",
+			number:   1,
+			set:      []string{"Priority=1"},
+		},
+		client: client,
+	}
+
+	err = runCreateItem(config)
+	assert.EqualError(t, err, `invalid field value "Priority=1", no field named "Priority"`)
+}
+
+func mockRepoIssue() {
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "an ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get repository ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query Repository.*",
+			"variables": map[string]interface{}{
+				"owner": "cli",
+				"name":  "go-gh",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"id":            "repo ID",
+					"nameWithOwner": "cli/go-gh",
+				},
+			},
+		})
+
+	// get labels and milestones
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query RepositoryMetadata.*",
+			"variables": map[string]interface{}{
+				"owner": "cli",
+				"name":  "go-gh",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"id": "bug ID", "name": "bug"},
+							{"id": "docs ID", "name": "docs"},
+						},
+					},
+					"milestones": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"id": "milestone ID", "title": "v2.0"},
+						},
+					},
+				},
+			},
+		})
+
+	// create issue
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateIssue.*","variables":{"input":{"repositoryId":"repo ID","title":"a title","body":"a body","milestoneId":"milestone ID","labelIds":\["bug ID"\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createIssue": map[string]interface{}{
+					"issue": map[string]interface{}{
+						"id":  "issue ID",
+						"url": "https://github.com/cli/go-gh/issues/1",
+					},
+				},
+			},
+		})
+}
+
+func TestRunCreateItem_Repo(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockRepoIssue()
+
+	// add issue to project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"an ID","contentId":"issue ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item ID",
+						"content": map[string]interface{}{
+							"__typename": "Issue",
+							"title":      "a title",
+							"body":       "a body",
+							"url":        "https://github.com/cli/go-gh/issues/1",
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := createItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: createItemOpts{
+			title:     "a title",
+			body:      "a body",
+			orgOwner:  "github",
+			number:    1,
+			repo:      "cli/go-gh",
+			labels:    []string{"Bug"},
+			milestone: "v2.0",
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runCreateItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"id":"item ID","title":"a title","body":"a body","type":"Issue","url":"https://github.com/cli/go-gh/issues/1","isArchived":false}`,
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunCreateItem_RepoAddFails(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockRepoIssue()
+
+	// add issue to project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*"`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"errors": []map[string]interface{}{
+				{"message": "project is closed"},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := createItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: createItemOpts{
+			title:     "a title",
+			body:      "a body",
+			orgOwner:  "github",
+			number:    1,
+			repo:      "cli/go-gh",
+			labels:    []string{"bug"},
+			milestone: "v2.0",
+		},
+		client: client,
+	}
+
+	err = runCreateItem(config)
+	assert.ErrorContains(t, err, "created issue https://github.com/cli/go-gh/issues/1, but could not add it to project 1, add it with `gh projects item-add 1 --url https://github.com/cli/go-gh/issues/1`: ")
+}
+
+func TestRunCreateItem_LabelWithoutRepo(t *testing.T) {
+	config := createItemConfig{
+		opts: createItemOpts{
+			title:  "a title",
+			labels: []string{"bug"},
+		},
+	}
+
+	err := runCreateItem(config)
+	assert.EqualError(t, err, "--label and --milestone require --repo")
+}
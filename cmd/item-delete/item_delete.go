@@ -9,6 +9,8 @@ import (
 	"github.com/cli/go-gh/v2/pkg/api"
 	"github.com/cli/go-gh/v2/pkg/tableprinter"
 	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/filter"
+	"github.com/github/gh-projects/format"
 	"github.com/github/gh-projects/queries"
 	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
@@ -21,6 +23,9 @@ type deleteItemOpts struct {
 	itemID    string
 	projectID string
 	format    string
+	query     string
+	dryRun    bool
+	yes       bool
 }
 
 type deleteItemConfig struct {
@@ -50,6 +55,15 @@ gh projects item-delete 1 --user monalisa --id ID
 # delete an item in the github org project 1
 gh projects item-delete 1 --org github --id ID
 
+# delete all the closed issues of org github's project 1, including archived ones
+gh projects item-delete 1 --org github --query "is:issue is:closed"
+
+# list the items that would be deleted, without deleting them
+gh projects item-delete 1 --org github --query "status:Done" --dry-run
+
+# select the item to delete interactively
+gh projects item-delete 1 --org github
+
 # add --format=json to output in JSON format
 `,
 		Args: cobra.MaximumNArgs(1),
@@ -85,11 +99,14 @@ gh projects item-delete 1 --org github --id ID
 
 	deleteItemCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
 	deleteItemCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
-	deleteItemCmd.Flags().StringVar(&opts.itemID, "id", "", "Global ID of the item to delete from the project.")
+	deleteItemCmd.Flags().StringVar(&opts.itemID, "id", "", "Global ID of the item to delete from the project. If omitted, the item is selected interactively.")
 	deleteItemCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+	deleteItemCmd.Flags().StringVar(&opts.query, "query", "", "Delete all the items matching a filter, such as \"status:Done updated:<2023-01-01\". Archived items are included.")
+	deleteItemCmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the items matching --query without deleting them.")
+	deleteItemCmd.Flags().BoolVar(&opts.yes, "yes", false, "Delete the items matching --query without confirmation.")
 
 	deleteItemCmd.MarkFlagsMutuallyExclusive("user", "org")
-	_ = deleteItemCmd.MarkFlagRequired("id")
+	deleteItemCmd.MarkFlagsMutuallyExclusive("id", "query")
 
 	return deleteItemCmd
 }
@@ -110,6 +127,18 @@ func runDeleteItem(config deleteItemConfig) error {
 	}
 	config.opts.projectID = project.ID
 
+	if config.opts.query != "" {
+		return runDeleteQuery(config, owner, project)
+	}
+
+	if config.opts.itemID == "" {
+		item, err := queries.NewItem(config.client, owner, project.Number)
+		if err != nil {
+			return err
+		}
+		config.opts.itemID = item.ID()
+	}
+
 	query, variables := deleteItemArgs(config)
 	err = config.client.Mutate("DeleteProjectItem", query, variables)
 	if err != nil {
@@ -124,6 +153,61 @@ func runDeleteItem(config deleteItemConfig) error {
 
 }
 
+// runDeleteQuery deletes all the items matching the --query filter after confirmation.
+func runDeleteQuery(config deleteItemConfig, owner *queries.Owner, project *queries.Project) error {
+	f, err := filter.Parse(config.opts.query)
+	if err != nil {
+		return err
+	}
+
+	p, err := queries.ProjectItems(config.client, owner, project.Number, 0)
+	if err != nil {
+		return err
+	}
+
+	items := f.Items(p.Items.Nodes)
+	if config.opts.format == "json" && (len(items) == 0 || config.opts.dryRun) {
+		return printBulkJSON(config, items)
+	}
+
+	if len(items) == 0 {
+		config.tp.AddField("No items to delete match the query")
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	if config.opts.dryRun {
+		return format.PrintItems(config.tp, items)
+	}
+
+	if !config.opts.yes {
+		ok, err := queries.Confirm(fmt.Sprintf("Delete %s matching %q?", format.Pluralize(len(items), "item"), config.opts.query), "use --yes to confirm")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, i := range items {
+		ids = append(ids, i.ID())
+	}
+	n, err := queries.BulkItemMutation(config.client, "DeleteProjectItems", "deleteProjectV2Item", project.ID, ids)
+	if err != nil {
+		return fmt.Errorf("deleted %d of %d items: %w", n, len(ids), err)
+	}
+
+	if config.opts.format == "json" {
+		return printBulkJSON(config, items)
+	}
+
+	config.tp.AddField(fmt.Sprintf("Deleted %s", format.Pluralize(n, "item")))
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
 func deleteItemArgs(config deleteItemConfig) (*deleteProjectItemMutation, map[string]interface{}) {
 	return &deleteProjectItemMutation{}, map[string]interface{}{
 		"input": githubv4.DeleteProjectV2ItemInput{
@@ -144,3 +228,12 @@ func printJSON(config deleteItemConfig, ID githubv4.ID) error {
 	config.tp.AddField(fmt.Sprintf(`{"id": "%s"}`, ID))
 	return config.tp.Render()
 }
+
+func printBulkJSON(config deleteItemConfig, items []queries.ProjectItem) error {
+	b, err := format.JSONBulkItems("deleted", config.opts.dryRun, items)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
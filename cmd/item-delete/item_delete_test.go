@@ -2,10 +2,12 @@ package itemdelete
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/cli/go-gh/v2/pkg/api"
 	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/github/gh-projects/queries"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
 )
@@ -254,3 +256,462 @@ func TestRunDelete_Me(t *testing.T) {
 		"Deleted item\n",
 		buf.String())
 }
+
+// confirmPrompter answers confirmations with answer and records the messages.
+type confirmPrompter struct {
+	answer   bool
+	messages []string
+}
+
+func (p *confirmPrompter) Select(message string, options []string) (int, error) {
+	return 0, fmt.Errorf("unexpected select %q", message)
+}
+
+func (p *confirmPrompter) Input(message string, validate func(string) error) (string, error) {
+	return "", fmt.Errorf("unexpected input %q", message)
+}
+
+func (p *confirmPrompter) Confirm(message string) (bool, error) {
+	p.messages = append(p.messages, message)
+	return p.answer, nil
+}
+
+// mockQueryProject mocks the user monalisa's project 1 with the given items.
+func mockQueryProject(items []map[string]interface{}) {
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "an ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  100,
+				"afterItems":  nil,
+				"firstFields": 100,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": items,
+						},
+					},
+				},
+			},
+		})
+}
+
+func releaseItems() []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"id": "item 1",
+			"content": map[string]interface{}{
+				"__typename": "DraftIssue",
+				"title":      "plan release",
+			},
+		},
+		{
+			"id":         "item 2",
+			"isArchived": true,
+			"content": map[string]interface{}{
+				"__typename": "DraftIssue",
+				"title":      "ship release",
+			},
+		},
+		{
+			"id": "item 3",
+			"content": map[string]interface{}{
+				"__typename": "DraftIssue",
+				"title":      "write docs",
+			},
+		},
+	}
+}
+
+// draftItems returns n draft issues with IDs "item 1" to "item n".
+func draftItems(n int) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, n)
+	for i := 1; i <= n; i++ {
+		items = append(items, map[string]interface{}{
+			"id": fmt.Sprintf("item %d", i),
+			"content": map[string]interface{}{
+				"__typename": "DraftIssue",
+				"title":      fmt.Sprintf("task %d", i),
+			},
+		})
+	}
+	return items
+}
+
+func TestRunDelete_QueryDryRun(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockQueryProject(releaseItems())
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			query:     "release",
+			dryRun:    true,
+		},
+		client: client,
+	}
+
+	err = runDeleteItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Type\tTitle\tNumber\tRepository\tID\nDraftIssue\tplan release\t - \t - \titem 1\nDraftIssue\tship release\t - \t - \titem 2\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunDelete_QueryYes(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockQueryProject(releaseItems())
+
+	// delete the matching items, archived ones included
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteProjectItems.*item0: deleteProjectV2Item.*item1: deleteProjectV2Item.*","variables":{"item0":"item 1","item1":"item 2","projectId":"an ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"item0": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+				"item1": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			query:     "release",
+			yes:       true,
+		},
+		client: client,
+	}
+
+	err = runDeleteItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Deleted 2 items\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunDelete_QueryJSON(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockQueryProject(releaseItems())
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteProjectItems.*","variables":{"item0":"item 1","item1":"item 2","projectId":"an ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"item0": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+				"item1": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			query:     "release",
+			yes:       true,
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runDeleteItem(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"action":"deleted","dryRun":false,"items":[{"id":"item 1","title":"plan release","body":"","type":"DraftIssue","isArchived":false},{"id":"item 2","title":"ship release","body":"","type":"DraftIssue","isArchived":true}],"totalCount":2}`,
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunDelete_QueryDryRunJSON(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockQueryProject(releaseItems())
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			query:     "docs",
+			dryRun:    true,
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runDeleteItem(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"action":"deleted","dryRun":true,"items":[{"id":"item 3","title":"write docs","body":"","type":"DraftIssue","isArchived":false}],"totalCount":1}`,
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunDelete_QueryDeclined(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockQueryProject(releaseItems())
+
+	prompter := &confirmPrompter{answer: false}
+	queries.SetPrompter(prompter)
+	defer queries.SetPrompter(nil)
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			query:     "release",
+		},
+		client: client,
+	}
+
+	// no delete mutation is mocked, so any request would fail
+	err = runDeleteItem(config)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`Delete 2 items matching "release"?`}, prompter.messages)
+	assert.Equal(t, "", buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunDelete_QueryBatches(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockQueryProject(draftItems(queries.BulkBatchSize + 1))
+
+	// the first batch holds BulkBatchSize items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteProjectItems.*item49: deleteProjectV2Item.*","variables":{"item0":"item 1",.*"item49":"item 50",.*"projectId":"an ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{},
+		})
+
+	// the second batch holds the remaining item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteProjectItems.*","variables":{"item0":"item 51","projectId":"an ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			query:     "task",
+			yes:       true,
+		},
+		client: client,
+	}
+
+	err = runDeleteItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Deleted 51 items\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunDelete_QueryPartialFailure(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockQueryProject(draftItems(queries.BulkBatchSize + 1))
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteProjectItems.*item49: deleteProjectV2Item.*","variables":{"item0":"item 1",.*"projectId":"an ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{},
+		})
+
+	// the second batch fails
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteProjectItems.*","variables":{"item0":"item 51","projectId":"an ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"errors": []map[string]interface{}{
+				{"message": "something went wrong"},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			query:     "task",
+			yes:       true,
+		},
+		client: client,
+	}
+
+	err = runDeleteItem(config)
+	assert.EqualError(t, err, "deleted 50 of 51 items: GraphQL: something went wrong")
+	assert.Equal(t, "", buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunDelete_QueryPartialBatch(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockQueryProject(draftItems(3))
+
+	// the items before and after the failed one are deleted
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteProjectItems.*","variables":{"item0":"item 1","item1":"item 2","item2":"item 3","projectId":"an ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"item0": map[string]interface{}{"clientMutationId": nil},
+				"item1": nil,
+				"item2": map[string]interface{}{"clientMutationId": nil},
+			},
+			"errors": []map[string]interface{}{
+				{"message": "something went wrong"},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			query:     "task",
+			yes:       true,
+		},
+		client: client,
+	}
+
+	err = runDeleteItem(config)
+	assert.EqualError(t, err, "deleted 2 of 3 items: GraphQL: something went wrong")
+	assert.True(t, gock.IsDone())
+}
@@ -3,6 +3,8 @@ package itemedit
 import (
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/cli/go-gh/v2/pkg/api"
 	"github.com/cli/go-gh/v2/pkg/tableprinter"
 	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/draft"
 	"github.com/github/gh-projects/format"
 	"github.com/github/gh-projects/queries"
 	"github.com/shurcooL/githubv4"
@@ -18,10 +21,19 @@ import (
 )
 
 type editItemOpts struct {
+	// item selection
+	userOwner     string
+	orgOwner      string
+	projectNumber int
 	// updateDraftIssue
-	title  string
-	body   string
-	itemID string
+	title     string
+	body      string
+	bodyFile  string
+	editor    bool
+	assignees []string
+	itemID    string
+	// editorCommand is the editor used with --editor
+	editorCommand string
 	// updateItem
 	fieldID              string
 	projectID            string
@@ -55,16 +67,24 @@ type UpdateProjectV2FieldValue struct {
 func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error) *cobra.Command {
 	opts := editItemOpts{}
 	editItemCmd := &cobra.Command{
-		Use:   "item-edit",
+		Use:   "item-edit [number]",
 		Short: "Edit an item in a project by ID",
 		Long: `
-Edit one of a draft issue or a project item. Both require the ID of the item to edit. For non-draft issues, the ID of the project is also required, and only a single field value can be updated per invocation. See the flags for more details.`,
+Edit one of a draft issue or a project item. Both require the ID of the item to edit. For non-draft issues, the ID of the project is also required, and only a single field value can be updated per invocation. See the flags for more details.
+
+When the ID is omitted in an interactive terminal, the project and item are selected from a list instead. If no value is given either, the field and its new value are prompted for as well.`,
 		Example: `
 # add --format=json to output in JSON format
 
 # edit a draft issue title and body
 gh projects item-edit --id DRAFT_ISSUE_CONTENT_ID --title "a new title" --body "a new body"
 
+# replace the body of a draft issue with the contents of a file, and assign it to the current user
+gh projects item-edit --id DRAFT_ISSUE_CONTENT_ID --body-file notes.md --assignee "@me"
+
+# edit the title and body of a draft issue in your editor
+gh projects item-edit --id DRAFT_ISSUE_CONTENT_ID --editor
+
 # edit an item's text field value
 gh projects item-edit --id ITEM_ID --field-id FIELD_ID --project-id PROJECT_ID --text "new text"
 
@@ -80,13 +100,24 @@ gh projects item-edit --id ITEM_ID --field-id FIELD_ID --project-id PROJECT_ID -
 
 # edit an item's iteration field value
 gh projects item-edit --id ITEM_ID --field-id FIELD_ID --project-id PROJECT_ID --iteration-id ITERATION_ID
+
+# select an item of org github's project 1 and the field value to set interactively
+gh projects item-edit 1 --org github
 `,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := queries.NewClient()
 			if err != nil {
 				return err
 			}
 
+			if len(args) == 1 {
+				opts.projectNumber, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
 			terminal := term.FromEnv()
 			termWidth, _, err := terminal.Size()
 			if err != nil {
@@ -95,6 +126,20 @@ gh projects item-edit --id ITEM_ID --field-id FIELD_ID --project-id PROJECT_ID -
 			}
 			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
 
+			if opts.bodyFile != "" {
+				opts.body, err = draft.ReadBody(opts.bodyFile, os.Stdin)
+				if err != nil {
+					return err
+				}
+			}
+
+			if opts.editor {
+				opts.editorCommand, err = cmdutil.DetermineEditor(f.Config)
+				if err != nil {
+					return err
+				}
+			}
+
 			config := editItemConfig{
 				tp:     t,
 				client: client,
@@ -104,11 +149,16 @@ gh projects item-edit --id ITEM_ID --field-id FIELD_ID --project-id PROJECT_ID -
 		},
 	}
 
-	editItemCmd.Flags().StringVar(&opts.itemID, "id", "", "ID of the item to edit (required). For draft issues, the ID is for the draft issue content which is prefixed with `DI_`. For other issues, it is the ID of the project item.")
+	editItemCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner, used to select the item interactively. Use \"@me\" for the current user.")
+	editItemCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner, used to select the item interactively.")
+	editItemCmd.Flags().StringVar(&opts.itemID, "id", "", "ID of the item to edit. For draft issues, the ID is for the draft issue content which is prefixed with `DI_`. For other issues, it is the ID of the project item. If omitted, the item is selected interactively.")
 	editItemCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
 
 	editItemCmd.Flags().StringVar(&opts.title, "title", "", "DRAFT ISSUE - Title of the draft issue item to edit.")
 	editItemCmd.Flags().StringVar(&opts.body, "body", "", "DRAFT ISSUE - Body of the draft issue item to edit.")
+	editItemCmd.Flags().StringVar(&opts.bodyFile, "body-file", "", "DRAFT ISSUE - Read the body of the draft issue item from a file. Use \"-\" to read from standard input.")
+	editItemCmd.Flags().BoolVar(&opts.editor, "editor", false, "DRAFT ISSUE - Edit the title and body of the draft issue item in a text editor.")
+	editItemCmd.Flags().StringSliceVar(&opts.assignees, "assignee", nil, "DRAFT ISSUE - Logins of the users to assign to the draft issue item, replacing the current assignees. Use \"@me\" for the current user.")
 
 	editItemCmd.Flags().StringVar(&opts.fieldID, "field-id", "", "ID of the field to update.")
 	editItemCmd.Flags().StringVar(&opts.projectID, "project-id", "", "ID of the project to which the field belongs to.")
@@ -118,15 +168,24 @@ gh projects item-edit --id ITEM_ID --field-id FIELD_ID --project-id PROJECT_ID -
 	editItemCmd.Flags().StringVar(&opts.singleSelectOptionID, "single-select-option-id", "", "ID of the single select option value to set on the field.")
 	editItemCmd.Flags().StringVar(&opts.iterationID, "iteration-id", "", "ID of the iteration value to set on the field.")
 
+	// owner can be a user or an org
+	editItemCmd.MarkFlagsMutuallyExclusive("user", "org")
+	editItemCmd.MarkFlagsMutuallyExclusive("body", "body-file", "editor")
 	editItemCmd.MarkFlagsMutuallyExclusive("text", "number", "date", "single-select-option-id", "iteration-id")
-	_ = editItemCmd.MarkFlagRequired("id")
 
 	return editItemCmd
 }
 
 func runEditItem(config editItemConfig) error {
+	if config.opts.itemID == "" {
+		err := selectItem(&config)
+		if err != nil {
+			return err
+		}
+	}
+
 	// update draft issue
-	if config.opts.title != "" || config.opts.body != "" {
+	if editsDraftIssue(config.opts) {
 		if !strings.HasPrefix(config.opts.itemID, "DI_") {
 			return errors.New("ID must be the ID of the draft issue content which is prefixed with `DI_`")
 		}
@@ -135,9 +194,21 @@ func runEditItem(config editItemConfig) error {
 			return fmt.Errorf("format must be 'json'")
 		}
 
-		query, variables := buildEditDraftIssue(config)
+		if config.opts.editor {
+			err := editDraftIssue(&config)
+			if err != nil {
+				return err
+			}
+		}
+
+		assigneeIDs, err := queries.UserIDs(config.client, config.opts.assignees)
+		if err != nil {
+			return err
+		}
+
+		query, variables := buildEditDraftIssue(config, assigneeIDs)
 
-		err := config.client.Mutate("EditDraftIssueItem", query, variables)
+		err = config.client.Mutate("EditDraftIssueItem", query, variables)
 		if err != nil {
 			return err
 		}
@@ -150,7 +221,7 @@ func runEditItem(config editItemConfig) error {
 	}
 
 	// update item values
-	if config.opts.text != "" || config.opts.number != 0 || config.opts.date != "" || config.opts.singleSelectOptionID != "" || config.opts.iterationID != "" {
+	if hasValue(config.opts) {
 		if config.opts.fieldID == "" {
 			return errors.New("field-id must be provided")
 		}
@@ -186,13 +257,237 @@ func runEditItem(config editItemConfig) error {
 
 }
 
-func buildEditDraftIssue(config editItemConfig) (*EditProjectDraftIssue, map[string]interface{}) {
+// selectItem prompts for the item to edit and, unless a value was given, the field and value to set.
+func selectItem(config *editItemConfig) error {
+	if !queries.CanPrompt() {
+		return queries.NoPromptError("use --id to select the item")
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.projectNumber, false)
+	if err != nil {
+		return err
+	}
+
+	// fetches the fields along with the items
+	project, err = queries.ProjectItems(config.client, owner, project.Number, 0)
+	if err != nil {
+		return err
+	}
+
+	item, err := queries.SelectItem(project.Items.Nodes)
+	if err != nil {
+		return err
+	}
+
+	if editsDraftIssue(config.opts) {
+		if item.Type() != "DraftIssue" {
+			return errors.New("only draft issues can have their title, body and assignees edited")
+		}
+		config.opts.itemID = item.Content.DraftIssue.ID
+		return nil
+	}
+
+	config.opts.itemID = item.ID()
+	config.opts.projectID = project.ID
+
+	if hasValue(config.opts) {
+		return nil
+	}
+
+	field, err := selectField(project.Fields.Nodes, config.opts.fieldID)
+	if err != nil {
+		return err
+	}
+	config.opts.fieldID = field.ID()
+
+	return promptValue(config, field)
+}
+
+// editsDraftIssue reports whether the options edit the content of a draft issue, rather than a field value.
+func editsDraftIssue(opts editItemOpts) bool {
+	return opts.title != "" || opts.body != "" || opts.bodyFile != "" || opts.editor || len(opts.assignees) > 0
+}
+
+// editDraftIssue opens the editor on the current title and body of the draft issue, or on
+// --title if given, and sets the edited title and body on the options.
+func editDraftIssue(config *editItemConfig) error {
+	current, err := queries.DraftIssueByID(config.client, config.opts.itemID)
+	if err != nil {
+		return err
+	}
+
+	title := current.Title
+	if config.opts.title != "" {
+		title = config.opts.title
+	}
+	config.opts.title, config.opts.body, err = draft.Edit(config.opts.editorCommand, title, current.Body, os.Stdin, os.Stdout, os.Stderr)
+	return err
+}
+
+func hasValue(opts editItemOpts) bool {
+	return opts.text != "" || opts.number != 0 || opts.date != "" || opts.singleSelectOptionID != "" || opts.iterationID != ""
+}
+
+// editableDataTypes are the data types of the fields whose values can be set with updateProjectV2ItemFieldValue.
+var editableDataTypes = map[string]bool{
+	"TEXT":          true,
+	"NUMBER":        true,
+	"DATE":          true,
+	"SINGLE_SELECT": true,
+	"ITERATION":     true,
+}
+
+// selectField returns the field with the given ID, or prompts for one of the editable fields if fieldID is empty.
+func selectField(fields []queries.ProjectField, fieldID string) (queries.ProjectField, error) {
+	editable := make([]queries.ProjectField, 0, len(fields))
+	for _, f := range fields {
+		if fieldID != "" && f.ID() == fieldID {
+			return f, nil
+		}
+		if editableDataTypes[f.DataType()] {
+			editable = append(editable, f)
+		}
+	}
+	if fieldID != "" {
+		return queries.ProjectField{}, fmt.Errorf("field %q not found", fieldID)
+	}
+	if len(editable) == 0 {
+		return queries.ProjectField{}, errors.New("no editable fields found")
+	}
+
+	options := make([]string, 0, len(editable))
+	for _, f := range editable {
+		options = append(options, f.Name())
+	}
+
+	answerIndex, err := queries.Select("Which field would you like to edit?", options, "use --field-id to select the field")
+	if err != nil {
+		return queries.ProjectField{}, err
+	}
+
+	return editable[answerIndex], nil
+}
+
+// promptValue prompts for the new value of field and sets it on the options.
+func promptValue(config *editItemConfig, field queries.ProjectField) error {
+	message := fmt.Sprintf("New value for %s", field.Name())
+
+	switch field.DataType() {
+	case "SINGLE_SELECT":
+		fieldOptions := field.Options()
+		if len(fieldOptions) == 0 {
+			return fmt.Errorf("field %q has no options", field.Name())
+		}
+		options := make([]string, 0, len(fieldOptions))
+		for _, o := range fieldOptions {
+			options = append(options, o.Name)
+		}
+		answerIndex, err := queries.Select(message, options, "use --single-select-option-id to set the value")
+		if err != nil {
+			return err
+		}
+		config.opts.singleSelectOptionID = fieldOptions[answerIndex].ID
+	case "ITERATION":
+		iterations, err := fieldIterations(config, field.ID())
+		if err != nil {
+			return err
+		}
+		if len(iterations) == 0 {
+			return fmt.Errorf("field %q has no iterations", field.Name())
+		}
+		options := make([]string, 0, len(iterations))
+		for _, i := range iterations {
+			options = append(options, fmt.Sprintf("%s (%s)", i.Title, i.StartDate))
+		}
+		answerIndex, err := queries.Select(message, options, "use --iteration-id to set the value")
+		if err != nil {
+			return err
+		}
+		config.opts.iterationID = iterations[answerIndex].ID
+	case "NUMBER":
+		answer, err := queries.Input(message, validateNumber, "use --number to set the value")
+		if err != nil {
+			return err
+		}
+		number, _ := strconv.ParseFloat(answer, 32)
+		config.opts.number = float32(number)
+	case "DATE":
+		date, err := queries.Input(message+" (YYYY-MM-DD)", validateDate, "use --date to set the value")
+		if err != nil {
+			return err
+		}
+		config.opts.date = date
+	default:
+		text, err := queries.Input(message, validateText, "use --text to set the value")
+		if err != nil {
+			return err
+		}
+		config.opts.text = text
+	}
+
+	return nil
+}
+
+// fieldIterations returns the iterations of a field, which are only fetched along with the details of the fields.
+func fieldIterations(config *editItemConfig, fieldID string) ([]queries.IterationFieldIteration, error) {
+	fields, err := queries.ProjectFieldsWithDetails(config.client, config.opts.projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if f.ID() == fieldID {
+			return f.Iterations(), nil
+		}
+	}
+	return nil, nil
+}
+
+func validateText(ans string) error {
+	if ans == "" {
+		return errors.New("value is required")
+	}
+	return nil
+}
+
+func validateNumber(ans string) error {
+	if _, err := strconv.ParseFloat(ans, 32); err != nil {
+		return errors.New("value must be a number")
+	}
+	return nil
+}
+
+func validateDate(ans string) error {
+	if _, err := time.Parse("2006-01-02", ans); err != nil {
+		return errors.New("value must be a date of the form YYYY-MM-DD")
+	}
+	return nil
+}
+
+func buildEditDraftIssue(config editItemConfig, assigneeIDs []string) (*EditProjectDraftIssue, map[string]interface{}) {
+	input := githubv4.UpdateProjectV2DraftIssueInput{
+		DraftIssueID: githubv4.ID(config.opts.itemID),
+	}
+	if config.opts.title != "" {
+		input.Title = githubv4.NewString(githubv4.String(config.opts.title))
+	}
+	// an edited body, or the body read from an empty file, may have been cleared on purpose
+	if config.opts.body != "" || config.opts.bodyFile != "" || config.opts.editor {
+		input.Body = githubv4.NewString(githubv4.String(config.opts.body))
+	}
+	if len(assigneeIDs) > 0 {
+		ids := make([]githubv4.ID, 0, len(assigneeIDs))
+		for _, id := range assigneeIDs {
+			ids = append(ids, githubv4.ID(id))
+		}
+		input.AssigneeIDs = &ids
+	}
 	return &EditProjectDraftIssue{}, map[string]interface{}{
-		"input": githubv4.UpdateProjectV2DraftIssueInput{
-			Body:         githubv4.NewString(githubv4.String(config.opts.body)),
-			DraftIssueID: githubv4.ID(config.opts.itemID),
-			Title:        githubv4.NewString(githubv4.String(config.opts.title)),
-		},
+		"input": input,
 	}
 }
 
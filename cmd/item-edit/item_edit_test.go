@@ -6,6 +6,7 @@ import (
 
 	"github.com/cli/go-gh/v2/pkg/api"
 	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/github/gh-projects/queries"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
 )
@@ -52,6 +53,125 @@ func TestRunItemEdit_Draft(t *testing.T) {
 		buf.String())
 }
 
+func TestRunItemEdit_DraftEmptyBodyFile(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// edit item, clearing the body
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation EditDraftIssueItem.*","variables":{"input":{"draftIssueId":"DI_item_id","body":""}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2DraftIssue": map[string]interface{}{
+					"draftIssue": map[string]interface{}{
+						"title": "a title",
+						"body":  "",
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := editItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: editItemOpts{
+			// the body read from an empty file
+			bodyFile: "empty.md",
+			itemID:   "DI_item_id",
+		},
+		client: client,
+	}
+
+	err = runEditItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Title\tBody\na title\t\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunItemEdit_DraftAssignees(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get assignee IDs
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "monalisa ID",
+				},
+			},
+		})
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerLogin.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"id":    "viewer ID",
+					"login": "hubot",
+				},
+			},
+		})
+
+	// edit item, leaving the title and body as they are
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation EditDraftIssueItem.*","variables":{"input":{"draftIssueId":"DI_item_id","assigneeIds":\["monalisa ID","viewer ID"\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2DraftIssue": map[string]interface{}{
+					"draftIssue": map[string]interface{}{
+						"title": "a title",
+						"body":  "a body",
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := editItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: editItemOpts{
+			assignees: []string{"monalisa", "@me"},
+			itemID:    "DI_item_id",
+		},
+		client: client,
+	}
+
+	err = runEditItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Title\tBody\na title\ta body\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
 func TestRunItemEdit_Text(t *testing.T) {
 	defer gock.Off()
 	gock.Observe(gock.DumpRequest)
@@ -316,8 +436,10 @@ func TestRunItemEdit_NoChanges(t *testing.T) {
 
 	buf := bytes.Buffer{}
 	config := editItemConfig{
-		tp:     tableprinter.New(&buf, false, 0),
-		opts:   editItemOpts{},
+		tp: tableprinter.New(&buf, false, 0),
+		opts: editItemOpts{
+			itemID: "item_id",
+		},
 		client: client,
 	}
 
@@ -350,3 +472,27 @@ func TestRunItemEdit_InvalidID(t *testing.T) {
 	err = runEditItem(config)
 	assert.Error(t, err, "ID must be the ID of the draft issue content which is prefixed with `DI_`")
 }
+
+func TestSelectField_ByID(t *testing.T) {
+	var title, status queries.ProjectField
+	title.TypeName = "ProjectV2Field"
+	title.Field.ID = "title ID"
+	title.Field.DataType = "TITLE"
+	status.TypeName = "ProjectV2SingleSelectField"
+	status.SingleSelectField.ID = "status ID"
+	status.SingleSelectField.DataType = "SINGLE_SELECT"
+
+	field, err := selectField([]queries.ProjectField{title, status}, "status ID")
+	assert.NoError(t, err)
+	assert.Equal(t, "status ID", field.ID())
+
+	_, err = selectField([]queries.ProjectField{title, status}, "missing ID")
+	assert.EqualError(t, err, `field "missing ID" not found`)
+}
+
+func TestValidateDate(t *testing.T) {
+	assert.NoError(t, validateDate("2023-01-01"))
+	assert.EqualError(t, validateDate("01/01/2023"), "value must be a date of the form YYYY-MM-DD")
+	assert.NoError(t, validateNumber("1.5"))
+	assert.EqualError(t, validateNumber("one"), "value must be a number")
+}
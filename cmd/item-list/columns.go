@@ -0,0 +1,109 @@
+package itemlist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/github/gh-projects/filter"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/github/gh-projects/settings"
+)
+
+// column is a column of the item table.
+type column struct {
+	name  string
+	value func(queries.ProjectItem) string
+}
+
+// defaultColumns are the columns used when none were given or remembered for the project.
+var defaultColumns = []string{"Type", "Title", "Number", "Repository", "ID"}
+
+// builtinColumns are the item attributes that can be used as columns, by lowercase name.
+// They take precedence over project fields of the same name.
+var builtinColumns = map[string]column{
+	"type":  {name: "Type", value: queries.ProjectItem.Type},
+	"title": {name: "Title", value: queries.ProjectItem.Title},
+	"number": {name: "Number", value: func(i queries.ProjectItem) string {
+		if i.Number() == 0 {
+			return ""
+		}
+		return strconv.Itoa(i.Number())
+	}},
+	"repository": {name: "Repository", value: queries.ProjectItem.Repo},
+	"repo":       {name: "Repository", value: queries.ProjectItem.Repo},
+	"state":      {name: "State", value: queries.ProjectItem.State},
+	"url":        {name: "URL", value: queries.ProjectItem.URL},
+	"id":         {name: "ID", value: queries.ProjectItem.ID},
+}
+
+// itemColumns returns the columns of the item table. The --columns flag is remembered as the default of
+// the project, and "default" forgets it. Columns are only remembered if the config has a settings path.
+func itemColumns(config listConfig, project *queries.Project) ([]column, error) {
+	names := defaultColumns
+	remember := false
+	if config.opts.columns != "" {
+		remember = true
+		if !strings.EqualFold(config.opts.columns, "default") {
+			names = strings.Split(config.opts.columns, ",")
+		}
+	}
+
+	var s *settings.Settings
+	if config.settingsPath != "" {
+		var err error
+		s, err = settings.Load(config.settingsPath)
+		if err != nil {
+			return nil, err
+		}
+		if saved := s.ProjectColumns(project.ID); !remember && len(saved) > 0 {
+			names = saved
+		}
+	}
+
+	columns := make([]column, 0, len(names))
+	for _, name := range names {
+		c, err := newColumn(project, strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+
+	if s != nil && remember {
+		var saved []string
+		if !strings.EqualFold(config.opts.columns, "default") {
+			for _, c := range columns {
+				saved = append(saved, c.name)
+			}
+		}
+		s.SetProjectColumns(project.ID, saved)
+		if err := s.Save(config.settingsPath); err != nil {
+			return nil, err
+		}
+	}
+
+	return columns, nil
+}
+
+// newColumn returns the column of a built-in attribute or project field.
+func newColumn(project *queries.Project, name string) (column, error) {
+	if c, ok := builtinColumns[strings.ToLower(name)]; ok {
+		return c, nil
+	}
+
+	field, err := findField(project, name)
+	if err != nil {
+		return column{}, fmt.Errorf("invalid column %q, it must be a project field or one of type, title, number, repository, state, url and id", name)
+	}
+	return column{
+		name: field.Name(),
+		value: func(i queries.ProjectItem) string {
+			if v := filter.FieldValue(i, field.Name()); v != nil {
+				return format.FieldValueText(*v)
+			}
+			return ""
+		},
+	}, nil
+}
@@ -0,0 +1,93 @@
+package itemlist
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/github/gh-projects/queries"
+	"github.com/stretchr/testify/assert"
+)
+
+func columnsProject() *queries.Project {
+	var status queries.ProjectField
+	status.TypeName = "ProjectV2SingleSelectField"
+	status.SingleSelectField.ID = "status ID"
+	status.SingleSelectField.Name = "Status"
+
+	project := &queries.Project{ID: "project ID"}
+	project.Fields.Nodes = []queries.ProjectField{status}
+
+	item := queries.ProjectItem{Id: "item ID"}
+	item.Content.TypeName = "DraftIssue"
+	item.Content.DraftIssue.Title = "a draft"
+	value := queries.FieldValueNodes{Type: "ProjectV2ItemFieldSingleSelectValue"}
+	value.ProjectV2ItemFieldSingleSelectValue.Name = "Done"
+	value.ProjectV2ItemFieldSingleSelectValue.Field = status
+	item.FieldValues.Nodes = []queries.FieldValueNodes{value}
+	project.Items.Nodes = []queries.ProjectItem{item}
+
+	return project
+}
+
+func columnNames(columns []column) []string {
+	names := make([]string, 0, len(columns))
+	for _, c := range columns {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+func TestItemColumns(t *testing.T) {
+	project := columnsProject()
+	config := listConfig{
+		settingsPath: filepath.Join(t.TempDir(), "settings.yml"),
+	}
+
+	columns, err := itemColumns(config, project)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultColumns, columnNames(columns))
+
+	// --columns is remembered for the project
+	config.opts.columns = "title, status,repo"
+	columns, err = itemColumns(config, project)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Title", "Status", "Repository"}, columnNames(columns))
+	item := project.Items.Nodes[0]
+	assert.Equal(t, "a draft", columns[0].value(item))
+	assert.Equal(t, "Done", columns[1].value(item))
+	assert.Equal(t, "", columns[2].value(item))
+
+	config.opts.columns = ""
+	columns, err = itemColumns(config, project)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Title", "Status", "Repository"}, columnNames(columns))
+
+	// "default" forgets them
+	config.opts.columns = "default"
+	columns, err = itemColumns(config, project)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultColumns, columnNames(columns))
+
+	config.opts.columns = ""
+	columns, err = itemColumns(config, project)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultColumns, columnNames(columns))
+}
+
+func TestItemColumns_Invalid(t *testing.T) {
+	config := listConfig{
+		settingsPath: filepath.Join(t.TempDir(), "settings.yml"),
+		opts: listOpts{
+			columns: "Title,Size",
+		},
+	}
+
+	_, err := itemColumns(config, columnsProject())
+	assert.EqualError(t, err, `invalid column "Size", it must be a project field or one of type, title, number, repository, state, url and id`)
+
+	// invalid columns are not remembered
+	config.opts.columns = ""
+	columns, err := itemColumns(config, columnsProject())
+	assert.NoError(t, err)
+	assert.Equal(t, defaultColumns, columnNames(columns))
+}
@@ -3,29 +3,41 @@ package itemlist
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cli/cli/v2/pkg/cmdutil"
 
 	"github.com/cli/go-gh/v2/pkg/api"
 	"github.com/cli/go-gh/v2/pkg/tableprinter"
 	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/filter"
 	"github.com/github/gh-projects/format"
 	"github.com/github/gh-projects/queries"
+	"github.com/github/gh-projects/settings"
 	"github.com/spf13/cobra"
 )
 
 type listOpts struct {
-	limit     string
-	userOwner string
-	orgOwner  string
-	number    int
-	format    string
+	limit           string
+	userOwner       string
+	orgOwner        string
+	number          int
+	format          string
+	view            string
+	sort            []string
+	groupBy         string
+	sum             string
+	columns         string
+	archived        bool
+	includeArchived bool
 }
 
 type listConfig struct {
 	tp     tableprinter.TablePrinter
 	client *api.GraphQLClient
 	opts   listOpts
+	// settingsPath is the file where the --columns of each project are remembered
+	settingsPath string
 }
 
 func parseLimit(limit string) (int, error) {
@@ -60,6 +72,21 @@ gh projects item-list 1 --user monalisa
 # list the items in org github's project number 1
 gh projects item-list 1 --org github
 
+# list the items in org github's project number 1 matching the filter and sort of its "Backlog" view
+gh projects item-list 1 --org github --view Backlog
+
+# list the items in org github's project number 1 by descending priority, then by title
+gh projects item-list 1 --org github --sort Priority:desc --sort title
+
+# count the items of org github's project number 1 per status, with the total of their estimates
+gh projects item-list 1 --org github --group-by Status --sum Estimate
+
+# list only the archived items of org github's project number 1
+gh projects item-list 1 --org github --archived
+
+# show the status and assignees of the items of org github's project number 1, and remember these columns
+gh projects item-list 1 --org github --columns "Title,Status,Assignees,Iteration"
+
 # add --format=json to output in JSON format
 `,
 		Args: cobra.MaximumNArgs(1),
@@ -85,9 +112,10 @@ gh projects item-list 1 --org github
 			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
 
 			config := listConfig{
-				tp:     t,
-				client: client,
-				opts:   opts,
+				tp:           t,
+				client:       client,
+				opts:         opts,
+				settingsPath: settings.DefaultPath(),
 			}
 			return runList(config)
 		},
@@ -97,8 +125,16 @@ gh projects item-list 1 --org github
 	listCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
 	listCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
 	listCmd.Flags().StringVar(&opts.limit, "limit", "", "Maximum number of items. Defaults to 100. Set to 'all' to list all items.")
+	listCmd.Flags().StringVar(&opts.view, "view", "", "Name or number of a view whose filter and sort are applied to the items.")
+	listCmd.Flags().StringArrayVar(&opts.sort, "sort", nil, "Sort the items by `FIELD[:asc|desc]`, where FIELD is a project field or one of title, number, repo and type. Can be repeated, and takes precedence over the sort of --view.")
+	listCmd.Flags().StringVar(&opts.groupBy, "group-by", "", "Group the items by the values of a project field, such as Status, Iteration, Assignees or Labels. All items are grouped unless --limit is given.")
+	listCmd.Flags().StringVar(&opts.sum, "sum", "", "Total the values of a number field in each group. Requires --group-by.")
+	listCmd.Flags().StringVar(&opts.columns, "columns", "", "Comma-separated columns of the table, each a project field or one of type, title, number, repository, state, url and id. Remembered as the default of the project, use 'default' to reset.")
+	listCmd.Flags().BoolVar(&opts.archived, "archived", false, "List only the archived items.")
+	listCmd.Flags().BoolVar(&opts.includeArchived, "include-archived", false, "List the archived items along with the others.")
 	// owner can be a user or an org
 	listCmd.MarkFlagsMutuallyExclusive("user", "org")
+	listCmd.MarkFlagsMutuallyExclusive("archived", "include-archived")
 
 	return listCmd
 }
@@ -108,6 +144,10 @@ func runList(config listConfig) error {
 		return fmt.Errorf("format must be 'json'")
 	}
 
+	if config.opts.sum != "" && config.opts.groupBy == "" {
+		return fmt.Errorf("--sum requires --group-by")
+	}
+
 	limit, err := parseLimit(config.opts.limit)
 	if err != nil {
 		return err
@@ -118,6 +158,10 @@ func runList(config listConfig) error {
 		return err
 	}
 
+	if config.opts.view != "" {
+		return runListView(config, owner, limit)
+	}
+
 	// no need to fetch the project if we already have the number
 	if config.opts.number == 0 {
 		project, err := queries.NewProject(config.client, owner, config.opts.number, false)
@@ -127,52 +171,273 @@ func runList(config listConfig) error {
 		config.opts.number = project.Number
 	}
 
+	if len(config.opts.sort) > 0 || config.opts.archived || config.opts.groupBy != "" {
+		return runListAll(config, owner, limit)
+	}
+
 	project, err := queries.ProjectItems(config.client, owner, config.opts.number, limit)
 	if err != nil {
 		return err
 	}
+	fetched := len(project.Items.Nodes)
+	project.Items.Nodes = archivedItems(config, project.Items.Nodes)
+
+	// archived items left out of a full page leave fewer items than the limit, although more
+	// items may follow, so all items are fetched to apply the limit after filtering. The JSON
+	// totalCount leaves out the archived items too, so it also needs all items to be fetched.
+	more := limit > 0 && fetched == limit
+	if more && (len(project.Items.Nodes) < limit || config.opts.format == "json" && !config.opts.includeArchived) {
+		return runListAll(config, owner, limit)
+	}
+	if !more {
+		project.Items.TotalCount = len(project.Items.Nodes)
+	}
+
+	return printItems(config, project, owner.Login)
+}
+
+// runListView lists the items matching the filter of a saved view, in the order of the view.
+// All items are fetched so that the limit applies to the matching items, and the JSON totalCount
+// is the number of matching items.
+func runListView(config listConfig, owner *queries.Owner, limit int) error {
+	p, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.number = p.Number
+
+	views, err := queries.ProjectViews(config.client, p.ID)
+	if err != nil {
+		return err
+	}
+	view, err := queries.FindProjectView(views, config.opts.view)
+	if err != nil {
+		return err
+	}
+	f, err := filter.Parse(view.Filter)
+	if err != nil {
+		return fmt.Errorf("view %q: %w", view.Name, err)
+	}
+
+	project, err := queries.ProjectItems(config.client, owner, config.opts.number, 0)
+	if err != nil {
+		return err
+	}
+
+	keys, err := sortKeys(config, project)
+	if err != nil {
+		return err
+	}
+
+	items := f.Items(archivedItems(config, project.Items.Nodes))
+	filter.Sort(items, append(keys, filter.SortKeysFromView(*view)...))
+	project.Items.TotalCount = len(items)
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	project.Items.Nodes = items
+
+	return printItems(config, project, owner.Login)
+}
+
+// runListAll lists the items sorted by the --sort keys, grouped by --group-by, or the archived or unarchived
+// items when the first page is not enough. All items are fetched so that the limit applies after filtering
+// and sorting. Groups count and total all the items unless --limit is given. The JSON totalCount is the
+// number of items before the limit.
+func runListAll(config listConfig, owner *queries.Owner, limit int) error {
+	if config.opts.groupBy != "" && config.opts.limit == "" {
+		limit = 0
+	}
+
+	project, err := queries.ProjectItems(config.client, owner, config.opts.number, 0)
+	if err != nil {
+		return err
+	}
+
+	keys, err := sortKeys(config, project)
+	if err != nil {
+		return err
+	}
+
+	items := archivedItems(config, project.Items.Nodes)
+	filter.Sort(items, keys)
+	project.Items.TotalCount = len(items)
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	project.Items.Nodes = items
+
+	return printItems(config, project, owner.Login)
+}
+
+// archivedItems keeps the items selected by --archived and --include-archived. Archived items are left out by default.
+func archivedItems(config listConfig, items []queries.ProjectItem) []queries.ProjectItem {
+	if config.opts.includeArchived {
+		return items
+	}
+
+	result := make([]queries.ProjectItem, 0, len(items))
+	for _, i := range items {
+		if i.IsArchived == config.opts.archived {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func sortKeys(config listConfig, project *queries.Project) ([]filter.SortKey, error) {
+	keys := make([]filter.SortKey, 0, len(config.opts.sort))
+	for _, s := range config.opts.sort {
+		key, err := filter.ParseSortKey(s, project.Fields.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func printItems(config listConfig, project *queries.Project, login string) error {
+	if config.opts.groupBy != "" {
+		return printGroups(config, project, login)
+	}
 
 	if config.opts.format == "json" {
 		return printJSON(config, project)
 	}
 
-	return printResults(config, project.Items.Nodes, owner.Login)
+	columns, err := itemColumns(config, project)
+	if err != nil {
+		return err
+	}
+
+	return printResults(config, project.Items.Nodes, columns, login)
 }
 
-func printResults(config listConfig, items []queries.ProjectItem, login string) error {
-	if len(items) == 0 {
+// printGroups prints the items grouped by the --group-by field, with the count and the
+// --sum total of each group on the first row of the group.
+func printGroups(config listConfig, project *queries.Project, login string) error {
+	groupBy, err := findField(project, config.opts.groupBy)
+	if err != nil {
+		return err
+	}
+	var sum *queries.ProjectField
+	if config.opts.sum != "" {
+		sum, err = findField(project, config.opts.sum)
+		if err != nil {
+			return err
+		}
+		if sum.DataType() != "NUMBER" {
+			return fmt.Errorf("field %q is not a number field", sum.Name())
+		}
+	}
+
+	groups := make([]format.ItemGroup, 0)
+	for _, g := range filter.GroupItems(project.Items.Nodes, groupBy.Name()) {
+		group := format.ItemGroup{Value: g.Value, Items: g.Items}
+		if sum != nil {
+			total := filter.Sum(g.Items, sum.Name())
+			group.Sum = &total
+		}
+		groups = append(groups, group)
+	}
+
+	if config.opts.format == "json" {
+		b, err := format.JSONProjectItemGroups(project, groups)
+		if err != nil {
+			return err
+		}
+		config.tp.AddField(string(b))
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	if len(groups) == 0 {
 		config.tp.AddField(fmt.Sprintf("Project %d for login %s has no items", config.opts.number, login))
 		config.tp.EndRow()
 		return config.tp.Render()
 	}
 
-	config.tp.AddField("Type")
-	config.tp.AddField("Title")
-	config.tp.AddField("Number")
-	config.tp.AddField("Repository")
-	config.tp.AddField("ID")
+	columns, err := itemColumns(config, project)
+	if err != nil {
+		return err
+	}
+
+	config.tp.AddField(groupBy.Name())
+	addHeaderFields(config, columns)
 	config.tp.EndRow()
 
-	for _, i := range items {
-		config.tp.AddField(i.Type())
-		config.tp.AddField(i.Title())
-		if i.Number() == 0 {
-			config.tp.AddField(" - ")
-		} else {
-			config.tp.AddField(fmt.Sprintf("%d", i.Number()))
+	for _, g := range groups {
+		for n, i := range g.Items {
+			if n == 0 {
+				config.tp.AddField(groupHeader(g, groupBy, sum))
+			} else {
+				config.tp.AddField("")
+			}
+			addItemFields(config, columns, i)
+			config.tp.EndRow()
 		}
-		if i.Repo() == "" {
-			config.tp.AddField(" - ")
-		} else {
-			config.tp.AddField(i.Repo())
+	}
+
+	return config.tp.Render()
+}
+
+// groupHeader describes a group, such as "Todo (2, Estimate: 5)".
+func groupHeader(g format.ItemGroup, groupBy *queries.ProjectField, sum *queries.ProjectField) string {
+	value := g.Value
+	if value == "" {
+		value = "No " + groupBy.Name()
+	}
+	if sum != nil {
+		return fmt.Sprintf("%s (%d, %s: %s)", value, len(g.Items), sum.Name(), strconv.FormatFloat(float64(*g.Sum), 'f', -1, 32))
+	}
+	return fmt.Sprintf("%s (%d)", value, len(g.Items))
+}
+
+// findField returns the project field with the given name, compared case-insensitively.
+func findField(project *queries.Project, name string) (*queries.ProjectField, error) {
+	for i, f := range project.Fields.Nodes {
+		if strings.EqualFold(f.Name(), name) {
+			return &project.Fields.Nodes[i], nil
 		}
-		config.tp.AddField(i.ID())
+	}
+	return nil, fmt.Errorf("field %q not found", name)
+}
+
+func printResults(config listConfig, items []queries.ProjectItem, columns []column, login string) error {
+	if len(items) == 0 {
+		config.tp.AddField(fmt.Sprintf("Project %d for login %s has no items", config.opts.number, login))
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	addHeaderFields(config, columns)
+	config.tp.EndRow()
+
+	for _, i := range items {
+		addItemFields(config, columns, i)
 		config.tp.EndRow()
 	}
 
 	return config.tp.Render()
 }
 
+func addHeaderFields(config listConfig, columns []column) {
+	for _, c := range columns {
+		config.tp.AddField(c.name)
+	}
+}
+
+func addItemFields(config listConfig, columns []column, i queries.ProjectItem) {
+	for _, c := range columns {
+		if value := c.value(i); value != "" {
+			config.tp.AddField(value)
+		} else {
+			config.tp.AddField(" - ")
+		}
+	}
+}
+
 func printJSON(config listConfig, project *queries.Project) error {
 	b, err := format.JSONProjectDetailedItems(project)
 	if err != nil {
@@ -2,6 +2,7 @@ package itemlist
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/cli/go-gh/v2/pkg/api"
@@ -307,3 +308,1432 @@ func TestRunList_Me(t *testing.T) {
 		"Type\tTitle\tNumber\tRepository\tID\nIssue\tan issue\t1\tcli/go-gh\tissue ID\nPullRequest\ta pull request\t2\tcli/go-gh\tpull request ID\nDraftIssue\tdraft issue\t - \t - \tdraft issue ID\n",
 		buf.String())
 }
+
+func TestRunList_View(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get project views
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectViews.*",
+			"variables": map[string]interface{}{
+				"id": "project ID",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"views": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"name":   "Backlog",
+								"number": 1,
+								"layout": "TABLE_LAYOUT",
+								"filter": "-is:draft",
+								"sortByFields": map[string]interface{}{
+									"nodes": []map[string]interface{}{
+										{
+											"direction": "DESC",
+											"field": map[string]interface{}{
+												"__typename": "ProjectV2Field",
+												"name":       "Points",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	points := func(n int) map[string]interface{} {
+		return map[string]interface{}{
+			"nodes": []map[string]interface{}{
+				{
+					"__typename": "ProjectV2ItemFieldNumberValue",
+					"number":     n,
+					"field": map[string]interface{}{
+						"__typename": "ProjectV2Field",
+						"name":       "Points",
+					},
+				},
+			},
+		}
+	}
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+									"fieldValues": points(1),
+								},
+								{
+									"id": "pull request ID",
+									"content": map[string]interface{}{
+										"__typename": "PullRequest",
+										"title":      "a pull request",
+										"number":     2,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+									"fieldValues": points(3),
+								},
+								{
+									"id": "draft issue ID",
+									"content": map[string]interface{}{
+										"title":      "draft issue",
+										"__typename": "DraftIssue",
+									},
+									"fieldValues": points(5),
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			view:      "backlog",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Type\tTitle\tNumber\tRepository\tID\nPullRequest\ta pull request\t2\tcli/go-gh\tpull request ID\nIssue\tan issue\t1\tcli/go-gh\tissue ID\n",
+		buf.String())
+}
+
+func TestRunList_Sort(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+								},
+								{
+									"id": "pull request ID",
+									"content": map[string]interface{}{
+										"__typename": "PullRequest",
+										"title":      "a pull request",
+										"number":     2,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+								},
+								{
+									"id": "draft issue ID",
+									"content": map[string]interface{}{
+										"title":      "draft issue",
+										"__typename": "DraftIssue",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			sort:      []string{"number:desc"},
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Type\tTitle\tNumber\tRepository\tID\nPullRequest\ta pull request\t2\tcli/go-gh\tpull request ID\nIssue\tan issue\t1\tcli/go-gh\tissue ID\nDraftIssue\tdraft issue\t - \t - \tdraft issue ID\n",
+		buf.String())
+}
+
+func TestRunList_GroupBy(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	status := map[string]interface{}{
+		"__typename": "ProjectV2SingleSelectField",
+		"id":         "status ID",
+		"name":       "Status",
+		"dataType":   "SINGLE_SELECT",
+		"options": []map[string]interface{}{
+			{"id": "todo ID", "name": "Todo"},
+			{"id": "done ID", "name": "Done"},
+		},
+	}
+	estimate := map[string]interface{}{
+		"__typename": "ProjectV2Field",
+		"id":         "estimate ID",
+		"name":       "Estimate",
+		"dataType":   "NUMBER",
+	}
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{status, estimate},
+						},
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{"__typename": "ProjectV2ItemFieldSingleSelectValue", "name": "Done", "optionId": "done ID", "field": status},
+											{"__typename": "ProjectV2ItemFieldNumberValue", "number": 3, "field": estimate},
+										},
+									},
+								},
+								{
+									"id": "draft issue ID",
+									"content": map[string]interface{}{
+										"title":      "draft issue",
+										"__typename": "DraftIssue",
+									},
+								},
+								{
+									"id": "other draft issue ID",
+									"content": map[string]interface{}{
+										"title":      "other draft issue",
+										"__typename": "DraftIssue",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{"__typename": "ProjectV2ItemFieldSingleSelectValue", "name": "Done", "optionId": "done ID", "field": status},
+											{"__typename": "ProjectV2ItemFieldNumberValue", "number": 2, "field": estimate},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			groupBy:   "status",
+			sum:       "estimate",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Status\tType\tTitle\tNumber\tRepository\tID\nDone (2, Estimate: 5)\tIssue\tan issue\t1\tcli/go-gh\tissue ID\n\tDraftIssue\tother draft issue\t - \t - \tother draft issue ID\nNo Status (1, Estimate: 0)\tDraftIssue\tdraft issue\t - \t - \tdraft issue ID\n",
+		buf.String())
+}
+
+func TestRunList_SumWithoutGroupBy(t *testing.T) {
+	config := listConfig{
+		opts: listOpts{
+			sum: "Estimate",
+		},
+	}
+
+	err := runList(config)
+	assert.EqualError(t, err, "--sum requires --group-by")
+}
+
+func TestRunList_Archived(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+								},
+								{
+									"id": "pull request ID",
+									"content": map[string]interface{}{
+										"__typename": "PullRequest",
+										"title":      "a pull request",
+										"number":     2,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+								},
+								{
+									"id":         "draft issue ID",
+									"isArchived": true,
+									"content": map[string]interface{}{
+										"title":      "draft issue",
+										"__typename": "DraftIssue",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			archived:  true,
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Type\tTitle\tNumber\tRepository\tID\nDraftIssue\tdraft issue\t - \t - \tdraft issue ID\n",
+		buf.String())
+}
+
+func TestRunList_ExcludesArchived(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+								},
+								{
+									"id": "pull request ID",
+									"content": map[string]interface{}{
+										"__typename": "PullRequest",
+										"title":      "a pull request",
+										"number":     2,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+								},
+								{
+									"id":         "draft issue ID",
+									"isArchived": true,
+									"content": map[string]interface{}{
+										"title":      "draft issue",
+										"__typename": "DraftIssue",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Type\tTitle\tNumber\tRepository\tID\nIssue\tan issue\t1\tcli/go-gh\tissue ID\nPullRequest\ta pull request\t2\tcli/go-gh\tpull request ID\n",
+		buf.String())
+}
+
+func TestRunList_LimitExcludesArchived(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	issue := map[string]interface{}{
+		"id": "issue ID",
+		"content": map[string]interface{}{
+			"__typename": "Issue",
+			"title":      "an issue",
+			"number":     1,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+	archived := map[string]interface{}{
+		"id":         "draft issue ID",
+		"isArchived": true,
+		"content": map[string]interface{}{
+			"title":      "draft issue",
+			"__typename": "DraftIssue",
+		},
+	}
+	pullRequest := map[string]interface{}{
+		"id": "pull request ID",
+		"content": map[string]interface{}{
+			"__typename": "PullRequest",
+			"title":      "a pull request",
+			"number":     2,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+
+	// the first page of 2 items holds an archived item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  2,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{issue, archived},
+						},
+					},
+				},
+			},
+		})
+
+	// so all the items are listed to fill the limit
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{issue, archived, pullRequest},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			limit:     "2",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Type\tTitle\tNumber\tRepository\tID\nIssue\tan issue\t1\tcli/go-gh\tissue ID\nPullRequest\ta pull request\t2\tcli/go-gh\tpull request ID\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunList_GroupByAllItems(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	status := map[string]interface{}{
+		"__typename": "ProjectV2SingleSelectField",
+		"id":         "status ID",
+		"name":       "Status",
+		"dataType":   "SINGLE_SELECT",
+		"options": []map[string]interface{}{
+			{"id": "done ID", "name": "Done"},
+		},
+	}
+	done := func(n int) []map[string]interface{} {
+		items := make([]map[string]interface{}, 0, n)
+		for i := 0; i < n; i++ {
+			items = append(items, map[string]interface{}{
+				"id": "draft issue ID",
+				"content": map[string]interface{}{
+					"title":      "draft issue",
+					"__typename": "DraftIssue",
+				},
+				"fieldValues": map[string]interface{}{
+					"nodes": []map[string]interface{}{
+						{"__typename": "ProjectV2ItemFieldSingleSelectValue", "name": "Done", "optionId": "done ID", "field": status},
+					},
+				},
+			})
+		}
+		return items
+	}
+
+	// the first page is full
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{status},
+						},
+						"items": map[string]interface{}{
+							"pageInfo": map[string]interface{}{
+								"hasNextPage": true,
+								"endCursor":   "cursor",
+							},
+							"nodes": done(queries.LimitMax),
+						},
+					},
+				},
+			},
+		})
+
+	// the items after the default limit are grouped too
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  "cursor",
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{status},
+						},
+						"items": map[string]interface{}{
+							"pageInfo": map[string]interface{}{
+								"hasNextPage": false,
+							},
+							"nodes": done(1),
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			groupBy:   "status",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "\nDone (101)\t")
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunList_JSONTotalCountExcludesArchived(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	issue := map[string]interface{}{
+		"id": "issue ID",
+		"content": map[string]interface{}{
+			"__typename": "Issue",
+			"title":      "an issue",
+			"number":     1,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+	archived := map[string]interface{}{
+		"id":         "draft issue ID",
+		"isArchived": true,
+		"content": map[string]interface{}{
+			"title":      "draft issue",
+			"__typename": "DraftIssue",
+		},
+	}
+	pullRequest := map[string]interface{}{
+		"id": "pull request ID",
+		"content": map[string]interface{}{
+			"__typename": "PullRequest",
+			"title":      "a pull request",
+			"number":     2,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes":      []map[string]interface{}{issue, archived, pullRequest},
+							"totalCount": 3,
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+
+	var result struct {
+		Items []struct {
+			ID string
+		}
+		TotalCount int
+	}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.Len(t, result.Items, 2)
+	assert.Equal(t, "issue ID", result.Items[0].ID)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunList_JSONTotalCountBeforeLimit(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	issue := map[string]interface{}{
+		"id": "issue ID",
+		"content": map[string]interface{}{
+			"__typename": "Issue",
+			"title":      "an issue",
+			"number":     1,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+	archived := map[string]interface{}{
+		"id":         "draft issue ID",
+		"isArchived": true,
+		"content": map[string]interface{}{
+			"title":      "draft issue",
+			"__typename": "DraftIssue",
+		},
+	}
+	pullRequest := map[string]interface{}{
+		"id": "pull request ID",
+		"content": map[string]interface{}{
+			"__typename": "PullRequest",
+			"title":      "a pull request",
+			"number":     2,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+
+	// the first page does not tell how many items are archived
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  1,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes":      []map[string]interface{}{issue},
+							"totalCount": 3,
+						},
+					},
+				},
+			},
+		})
+
+	// so all the items are listed to count them
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes":      []map[string]interface{}{issue, archived, pullRequest},
+							"totalCount": 3,
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			limit:     "1",
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+
+	var result struct {
+		Items []struct {
+			ID string
+		}
+		TotalCount int
+	}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, "issue ID", result.Items[0].ID)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunList_JSONTotalCountSort(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	issue := map[string]interface{}{
+		"id": "issue ID",
+		"content": map[string]interface{}{
+			"__typename": "Issue",
+			"title":      "an issue",
+			"number":     1,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+	archived := map[string]interface{}{
+		"id":         "draft issue ID",
+		"isArchived": true,
+		"content": map[string]interface{}{
+			"title":      "draft issue",
+			"__typename": "DraftIssue",
+		},
+	}
+	pullRequest := map[string]interface{}{
+		"id": "pull request ID",
+		"content": map[string]interface{}{
+			"__typename": "PullRequest",
+			"title":      "a pull request",
+			"number":     2,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes":      []map[string]interface{}{issue, archived, pullRequest},
+							"totalCount": 3,
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			limit:     "1",
+			sort:      []string{"title"},
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+
+	var result struct {
+		Items []struct {
+			ID string
+		}
+		TotalCount int
+	}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, "pull request ID", result.Items[0].ID)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunList_JSONTotalCountView(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get project views
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectViews.*",
+			"variables": map[string]interface{}{
+				"id": "project ID",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"views": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"name":   "Code",
+								"number": 1,
+								"layout": "TABLE_LAYOUT",
+								"filter": "-is:draft",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	issue := map[string]interface{}{
+		"id": "issue ID",
+		"content": map[string]interface{}{
+			"__typename": "Issue",
+			"title":      "an issue",
+			"number":     1,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+	archived := map[string]interface{}{
+		"id":         "draft issue ID",
+		"isArchived": true,
+		"content": map[string]interface{}{
+			"title":      "draft issue",
+			"__typename": "DraftIssue",
+		},
+	}
+	pullRequest := map[string]interface{}{
+		"id": "pull request ID",
+		"content": map[string]interface{}{
+			"__typename": "PullRequest",
+			"title":      "a pull request",
+			"number":     2,
+			"repository": map[string]string{
+				"nameWithOwner": "cli/go-gh",
+			},
+		},
+	}
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes":      []map[string]interface{}{issue, archived, pullRequest},
+							"totalCount": 3,
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			number:    1,
+			userOwner: "monalisa",
+			view:      "code",
+			limit:     "1",
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+
+	var result struct {
+		Items []struct {
+			ID string
+		}
+		TotalCount int
+	}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, "issue ID", result.Items[0].ID)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,146 @@
+package itemmove
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+type moveItemOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	itemID    string
+	afterID   string
+	top       bool
+	projectID string
+	format    string
+}
+
+type moveItemConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   moveItemOpts
+}
+
+func NewCmdMoveItem(f *cmdutil.Factory, runF func(config moveItemConfig) error) *cobra.Command {
+	opts := moveItemOpts{}
+	moveItemCmd := &cobra.Command{
+		Short: "Move an item in a project",
+		Long:  "Move an item in a project, which changes its position in the views that are not sorted by a field.",
+		Use:   "item-move [number]",
+		Example: `
+# move an item of org github's project 1 after another item
+gh projects item-move 1 --org github --id ID --after OTHER_ID
+
+# move an item to the top of the current user's project 1
+gh projects item-move 1 --user "@me" --id ID --top
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			if opts.afterID == "" && !opts.top {
+				return fmt.Errorf("one of --after or --top must be set")
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+			config := moveItemConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runMoveItem(config)
+		},
+	}
+
+	moveItemCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	moveItemCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	moveItemCmd.Flags().StringVar(&opts.itemID, "id", "", "ID of the item to move. If omitted, the item is selected interactively.")
+	moveItemCmd.Flags().StringVar(&opts.afterID, "after", "", "ID of the item to move the item after.")
+	moveItemCmd.Flags().BoolVar(&opts.top, "top", false, "Move the item to the top of the project.")
+	moveItemCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	// owner can be a user or an org
+	moveItemCmd.MarkFlagsMutuallyExclusive("user", "org")
+	moveItemCmd.MarkFlagsMutuallyExclusive("after", "top")
+
+	return moveItemCmd
+}
+
+func runMoveItem(config moveItemConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	if config.opts.itemID == "" {
+		item, err := queries.NewItem(config.client, owner, project.Number)
+		if err != nil {
+			return err
+		}
+		config.opts.itemID = item.ID()
+	}
+
+	afterID := config.opts.afterID
+	if config.opts.top {
+		afterID = ""
+	}
+	err = queries.MoveItem(config.client, config.opts.projectID, config.opts.itemID, afterID)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config)
+	}
+
+	return printResults(config)
+}
+
+func printResults(config moveItemConfig) error {
+	// using table printer here for consistency in case it ends up being needed in the future
+	config.tp.AddField("Moved item")
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+func printJSON(config moveItemConfig) error {
+	config.tp.AddField(fmt.Sprintf(`{"id": "%s"}`, config.opts.itemID))
+	return config.tp.Render()
+}
@@ -0,0 +1,144 @@
+package itemmove
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func mockUserProject() {
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+}
+
+func TestRunMove_After(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockUserProject()
+
+	// move item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation MoveProjectItem.*","variables":{"input":{"projectId":"project ID","itemId":"item ID","afterId":"other ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemPosition": map[string]interface{}{
+					"clientMutationId": "",
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := moveItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: moveItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			itemID:    "item ID",
+			afterID:   "other ID",
+		},
+		client: client,
+	}
+
+	err = runMoveItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Moved item\n",
+		buf.String())
+}
+
+func TestRunMove_Top(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockUserProject()
+
+	// move item without afterId
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation MoveProjectItem.*","variables":{"input":{"projectId":"project ID","itemId":"item ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemPosition": map[string]interface{}{
+					"clientMutationId": "",
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := moveItemConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: moveItemOpts{
+			userOwner: "monalisa",
+			number:    1,
+			itemID:    "item ID",
+			top:       true,
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runMoveItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"id": "item ID"}`,
+		buf.String())
+}
@@ -0,0 +1,237 @@
+package link
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/queries"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type linkOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	repo      string
+	team      string
+	undo      bool
+	projectID string
+}
+
+type linkConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   linkOpts
+}
+
+type linkRepositoryMutation struct {
+	LinkProjectV2ToRepository struct {
+		Repository queries.Repository `graphql:"repository"`
+	} `graphql:"linkProjectV2ToRepository(input:$input)"`
+}
+
+type unlinkRepositoryMutation struct {
+	UnlinkProjectV2FromRepository struct {
+		Repository queries.Repository `graphql:"repository"`
+	} `graphql:"unlinkProjectV2FromRepository(input:$input)"`
+}
+
+type linkTeamMutation struct {
+	LinkProjectV2ToTeam struct {
+		Team queries.Team `graphql:"team"`
+	} `graphql:"linkProjectV2ToTeam(input:$input)"`
+}
+
+type unlinkTeamMutation struct {
+	UnlinkProjectV2FromTeam struct {
+		Team queries.Team `graphql:"team"`
+	} `graphql:"unlinkProjectV2FromTeam(input:$input)"`
+}
+
+func NewCmdLink(f *cmdutil.Factory, runF func(config linkConfig) error) *cobra.Command {
+	opts := linkOpts{}
+	linkCmd := &cobra.Command{
+		Short: "Link a project to a repository or a team",
+		Use:   "link [number]",
+		Example: `
+# link user monalisa's project 1 to the repository monalisa/hello-world
+gh projects link 1 --user monalisa --repo monalisa/hello-world
+
+# link org github's project 1 to the team github/core
+gh projects link 1 --org github --team github/core
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCmd(opts, args)
+		},
+	}
+
+	addFlags(linkCmd, &opts)
+	return linkCmd
+}
+
+func NewCmdUnlink(f *cmdutil.Factory, runF func(config linkConfig) error) *cobra.Command {
+	opts := linkOpts{undo: true}
+	unlinkCmd := &cobra.Command{
+		Short: "Unlink a project from a repository or a team",
+		Use:   "unlink [number]",
+		Example: `
+# unlink user monalisa's project 1 from the repository monalisa/hello-world
+gh projects unlink 1 --user monalisa --repo monalisa/hello-world
+
+# unlink org github's project 1 from the team github/core
+gh projects unlink 1 --org github --team github/core
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCmd(opts, args)
+		},
+	}
+
+	addFlags(unlinkCmd, &opts)
+	return unlinkCmd
+}
+
+func addFlags(cmd *cobra.Command, opts *linkOpts) {
+	cmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	cmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	cmd.Flags().StringVar(&opts.repo, "repo", "", "Repository of the form OWNER/REPO.")
+	cmd.Flags().StringVar(&opts.team, "team", "", "Team of the form ORG/SLUG.")
+
+	cmd.MarkFlagsMutuallyExclusive("user", "org")
+	cmd.MarkFlagsMutuallyExclusive("repo", "team")
+}
+
+// runCmd builds the config shared by the link and unlink commands.
+func runCmd(opts linkOpts, args []string) error {
+	client, err := queries.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		opts.number, err = strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal := term.FromEnv()
+	termWidth, _, err := terminal.Size()
+	if err != nil {
+		// set a static width in case of error
+		termWidth = 80
+	}
+	t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+	config := linkConfig{
+		tp:     t,
+		client: client,
+		opts:   opts,
+	}
+	return runLink(config)
+}
+
+func runLink(config linkConfig) error {
+	if config.opts.repo == "" && config.opts.team == "" {
+		return fmt.Errorf("one of --repo or --team must be provided")
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	if config.opts.repo != "" {
+		repo, err := queries.NewRepository(config.client, config.opts.repo)
+		if err != nil {
+			return err
+		}
+		return linkRepository(config, project, repo)
+	}
+
+	team, err := queries.NewTeam(config.client, config.opts.team)
+	if err != nil {
+		return err
+	}
+	return linkTeam(config, project, team)
+}
+
+func linkRepository(config linkConfig, project *queries.Project, repo *queries.Repository) error {
+	if config.opts.undo {
+		query := &unlinkRepositoryMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.UnlinkProjectV2FromRepositoryInput{
+				ProjectID:    githubv4.ID(config.opts.projectID),
+				RepositoryID: githubv4.ID(repo.ID),
+			},
+		}
+		if err := config.client.Mutate("UnlinkProjectRepository", query, variables); err != nil {
+			return err
+		}
+		return printResults(config, project, repo.NameWithOwner)
+	}
+
+	query := &linkRepositoryMutation{}
+	variables := map[string]interface{}{
+		"input": githubv4.LinkProjectV2ToRepositoryInput{
+			ProjectID:    githubv4.ID(config.opts.projectID),
+			RepositoryID: githubv4.ID(repo.ID),
+		},
+	}
+	if err := config.client.Mutate("LinkProjectRepository", query, variables); err != nil {
+		return err
+	}
+	return printResults(config, project, repo.NameWithOwner)
+}
+
+func linkTeam(config linkConfig, project *queries.Project, team *queries.Team) error {
+	if config.opts.undo {
+		query := &unlinkTeamMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.UnlinkProjectV2FromTeamInput{
+				ProjectID: githubv4.ID(config.opts.projectID),
+				TeamID:    githubv4.ID(team.ID),
+			},
+		}
+		if err := config.client.Mutate("UnlinkProjectTeam", query, variables); err != nil {
+			return err
+		}
+		return printResults(config, project, team.CombinedSlug)
+	}
+
+	query := &linkTeamMutation{}
+	variables := map[string]interface{}{
+		"input": githubv4.LinkProjectV2ToTeamInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+			TeamID:    githubv4.ID(team.ID),
+		},
+	}
+	if err := config.client.Mutate("LinkProjectTeam", query, variables); err != nil {
+		return err
+	}
+	return printResults(config, project, team.CombinedSlug)
+}
+
+func printResults(config linkConfig, project *queries.Project, linked string) error {
+	// using table printer here for consistency in case it ends up being needed in the future
+	if config.opts.undo {
+		config.tp.AddField(fmt.Sprintf("Unlinked '%s' from project %d", linked, project.Number))
+	} else {
+		config.tp.AddField(fmt.Sprintf("Linked '%s' to project %d", linked, project.Number))
+	}
+	config.tp.EndRow()
+	return config.tp.Render()
+}
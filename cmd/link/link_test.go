@@ -0,0 +1,243 @@
+package link
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRunLink_Repo(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get repository ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query Repository.*",
+			"variables": map[string]interface{}{
+				"owner": "CLI",
+				"name":  "go-gh",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"id":            "repo ID",
+					"nameWithOwner": "cli/go-gh",
+				},
+			},
+		})
+
+	// link repository
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation LinkProjectRepository.*","variables":{"input":{"projectId":"project ID","repositoryId":"repo ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"linkProjectV2ToRepository": map[string]interface{}{
+					"repository": map[string]interface{}{
+						"id": "repo ID",
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := linkConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: linkOpts{
+			orgOwner: "github",
+			number:   1,
+			repo:     "CLI/go-gh",
+		},
+		client: client,
+	}
+
+	err = runLink(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Linked 'cli/go-gh' to project 1\n",
+		buf.String())
+}
+
+func TestRunUnlink_Team(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get team ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query Team.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+				"slug":  "core",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"team": map[string]interface{}{
+						"id":           "team ID",
+						"combinedSlug": "github/core",
+					},
+				},
+			},
+		})
+
+	// unlink team
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UnlinkProjectTeam.*","variables":{"input":{"projectId":"project ID","teamId":"team ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"unlinkProjectV2FromTeam": map[string]interface{}{
+					"team": map[string]interface{}{
+						"id": "team ID",
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := linkConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: linkOpts{
+			orgOwner: "github",
+			number:   1,
+			team:     "github/core",
+			undo:     true,
+		},
+		client: client,
+	}
+
+	err = runLink(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Unlinked 'github/core' from project 1\n",
+		buf.String())
+}
+
+func TestRunLink_MissingTarget(t *testing.T) {
+	config := linkConfig{
+		tp: tableprinter.New(&bytes.Buffer{}, false, 0),
+		opts: linkOpts{
+			orgOwner: "github",
+			number:   1,
+		},
+	}
+
+	err := runLink(config)
+	assert.EqualError(t, err, "one of --repo or --team must be provided")
+}
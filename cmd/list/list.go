@@ -21,6 +21,7 @@ type listOpts struct {
 	userOwner string
 	orgOwner  string
 	closed    bool
+	templates bool
 	format    string
 }
 
@@ -60,6 +61,9 @@ gh projects list --user monalisa --web
 # list the projects for org github including closed projects
 gh projects list --org github --closed
 
+# list the project templates for org github
+gh projects list --org github --templates
+
 # add --format=json to output in JSON format
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -93,8 +97,9 @@ gh projects list --org github --closed
 	listCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
 	listCmd.Flags().BoolVarP(&opts.closed, "closed", "c", false, "Show closed projects.")
 	listCmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open projects list in the browser.")
+	listCmd.Flags().BoolVar(&opts.templates, "templates", false, "Show only projects marked as templates, open or closed.")
 	listCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
-	listCmd.Flags().StringVar(&opts.limit, "limit", "", "Maximum number of projects. Defaults to 100. Set to 'all' to list all projects. Note that closed projects are filtered from the final results without the --closed flag.")
+	listCmd.Flags().StringVar(&opts.limit, "limit", "", "Maximum number of projects. Defaults to 100. Set to 'all' to list all projects. Note that closed projects are filtered from the final results without the --closed flag, unless --templates is given.")
 	// owner can be a user or an org
 	listCmd.MarkFlagsMutuallyExclusive("user", "org")
 
@@ -141,11 +146,23 @@ func runList(config listConfig) error {
 		ownerType = queries.ViewerOwner
 	}
 
-	projects, totalCount, err := queries.Projects(config.client, login, ownerType, limit, false)
+	// templates are only a few of the projects, so all projects are fetched to fill the limit with templates
+	fetchLimit := limit
+	if config.opts.templates {
+		fetchLimit = 0
+	}
+
+	projects, totalCount, err := queries.Projects(config.client, login, ownerType, fetchLimit, false)
 	if err != nil {
 		return err
 	}
 	projects = filterProjects(projects, config)
+	if config.opts.templates {
+		totalCount = len(projects)
+		if limit > 0 && len(projects) > limit {
+			projects = projects[:limit]
+		}
+	}
 
 	if config.opts.format == "json" {
 		return printJSON(config, projects, totalCount)
@@ -175,10 +192,16 @@ func buildURL(config listConfig) (string, error) {
 	return url, nil
 }
 
+// filterProjects leaves out the closed projects unless --closed is given. With --templates, it keeps
+// only the templates, open or closed, as templates are often closed once set up.
 func filterProjects(nodes []queries.Project, config listConfig) []queries.Project {
 	projects := make([]queries.Project, 0, len(nodes))
 	for _, p := range nodes {
-		if !config.opts.closed && p.Closed {
+		if config.opts.templates {
+			if !p.Template {
+				continue
+			}
+		} else if !config.opts.closed && p.Closed {
 			continue
 		}
 		projects = append(projects, p)
@@ -196,7 +219,7 @@ func printResults(config listConfig, projects []queries.Project, login string) e
 	config.tp.AddField("Title")
 	config.tp.AddField("Description")
 	config.tp.AddField("URL")
-	if config.opts.closed {
+	if config.opts.closed || config.opts.templates {
 		config.tp.AddField("State")
 	}
 	config.tp.AddField("ID")
@@ -210,7 +233,7 @@ func printResults(config listConfig, projects []queries.Project, login string) e
 			config.tp.AddField(p.ShortDescription)
 		}
 		config.tp.AddField(p.URL)
-		if config.opts.closed {
+		if config.opts.closed || config.opts.templates {
 			var state string
 			if p.Closed {
 				state = "closed"
@@ -331,3 +331,96 @@ func TestRunListWeb(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "https://github.com/users/monalisa/projects", buf.String())
 }
+
+func TestRunListTemplates(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(`
+			{"data":
+				{"organization":
+					{
+						"login":"github",
+						"projectsV2": {
+							"nodes": [
+								{"title": "Project 1", "shortDescription": "Short description 1", "url": "url1", "closed": false, "template": false, "ID": "1"},
+								{"title": "Template 2", "shortDescription": "", "url": "url2", "closed": false, "template": true, "ID": "2"}
+							]
+						}
+					}
+				}
+			}
+		`)
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			orgOwner:  "github",
+			templates: true,
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Title\tDescription\tURL\tState\tID\nTemplate 2\t - \turl2\topen\t2\n",
+		buf.String())
+}
+
+func TestRunListTemplates_Limit(t *testing.T) {
+	defer gock.Off()
+
+	// all the projects are listed to find the templates
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`.*"first":100.*`).
+		Reply(200).
+		JSON(`
+			{"data":
+				{"organization":
+					{
+						"login":"github",
+						"projectsV2": {
+							"nodes": [
+								{"title": "Project 1", "shortDescription": "Short description 1", "url": "url1", "closed": false, "template": false, "ID": "1"},
+								{"title": "Template 2", "shortDescription": "", "url": "url2", "closed": true, "template": true, "ID": "2"},
+								{"title": "Template 3", "shortDescription": "", "url": "url3", "closed": false, "template": true, "ID": "3"}
+							],
+							"totalCount": 3
+						}
+					}
+				}
+			}
+		`)
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			orgOwner:  "github",
+			templates: true,
+			limit:     "1",
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"projects":[{"number":0,"url":"url2","shortDescription":"","public":false,"closed":true,"title":"Template 2","id":"2","readme":"","items":{"totalCount":0},"fields":{"totalCount":0},"owner":{"type":"","login":""}}],"totalCount":2}`,
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
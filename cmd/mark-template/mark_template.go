@@ -0,0 +1,218 @@
+package marktemplate
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type markTemplateOpts struct {
+	orgOwner  string
+	number    int
+	undo      bool
+	projectID string
+	format    string
+}
+
+type markTemplateConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   markTemplateOpts
+}
+
+// MarkProjectV2AsTemplateInput is an input type of MarkProjectV2AsTemplate.
+type MarkProjectV2AsTemplateInput struct {
+	ProjectID githubv4.ID `json:"projectId"`
+}
+
+// UnmarkProjectV2AsTemplateInput is an input type of UnmarkProjectV2AsTemplate.
+type UnmarkProjectV2AsTemplateInput struct {
+	ProjectID githubv4.ID `json:"projectId"`
+}
+
+type markProjectTemplateMutation struct {
+	TemplateProject struct {
+		Project queries.Project `graphql:"projectV2"`
+	} `graphql:"markProjectV2AsTemplate(input:$input)"`
+}
+
+type unmarkProjectTemplateMutation struct {
+	TemplateProject struct {
+		Project queries.Project `graphql:"projectV2"`
+	} `graphql:"unmarkProjectV2AsTemplate(input:$input)"`
+}
+
+func NewCmdMarkTemplate(f *cmdutil.Factory, runF func(config markTemplateConfig) error) *cobra.Command {
+	opts := markTemplateOpts{}
+	markTemplateCmd := &cobra.Command{
+		Short: "Mark a project as a template",
+		Use:   "mark-template [number]",
+		Example: `
+# mark org github's project 1 as a template
+gh projects mark-template 1 --org github
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCmd(opts, args)
+		},
+	}
+
+	markTemplateCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	markTemplateCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	return markTemplateCmd
+}
+
+func NewCmdUnmarkTemplate(f *cmdutil.Factory, runF func(config markTemplateConfig) error) *cobra.Command {
+	opts := markTemplateOpts{undo: true}
+	unmarkTemplateCmd := &cobra.Command{
+		Short: "Unmark a project as a template",
+		Use:   "unmark-template [number]",
+		Example: `
+# unmark org github's project 1 as a template
+gh projects unmark-template 1 --org github
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCmd(opts, args)
+		},
+	}
+
+	unmarkTemplateCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	unmarkTemplateCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	return unmarkTemplateCmd
+}
+
+// runCmd builds the config shared by the mark-template and unmark-template commands.
+func runCmd(opts markTemplateOpts, args []string) error {
+	client, err := queries.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		opts.number, err = strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal := term.FromEnv()
+	termWidth, _, err := terminal.Size()
+	if err != nil {
+		// set a static width in case of error
+		termWidth = 80
+	}
+	t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+	config := markTemplateConfig{
+		tp:     t,
+		client: client,
+		opts:   opts,
+	}
+	return runMarkTemplate(config)
+}
+
+func runMarkTemplate(config markTemplateConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	owner, err := queries.NewOwner(config.client, "", config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+	if owner.Type != queries.OrgOwner {
+		return fmt.Errorf("only projects owned by an organization can be templates")
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	if config.opts.undo {
+		query, variables := unmarkTemplateArgs(config)
+		err = config.client.Mutate("UnmarkProjectTemplate", query, variables)
+		if err != nil {
+			return err
+		}
+
+		if config.opts.format == "json" {
+			return printJSON(config, query.TemplateProject.Project)
+		}
+
+		return printResults(config, query.TemplateProject.Project)
+	}
+
+	query, variables := markTemplateArgs(config)
+	err = config.client.Mutate("MarkProjectTemplate", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config, query.TemplateProject.Project)
+	}
+
+	return printResults(config, query.TemplateProject.Project)
+}
+
+func markTemplateArgs(config markTemplateConfig) (*markProjectTemplateMutation, map[string]interface{}) {
+	return &markProjectTemplateMutation{}, map[string]interface{}{
+		"input": MarkProjectV2AsTemplateInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+		},
+		"firstItems":  githubv4.Int(0),
+		"afterItems":  (*githubv4.String)(nil),
+		"firstFields": githubv4.Int(0),
+		"afterFields": (*githubv4.String)(nil),
+	}
+}
+
+func unmarkTemplateArgs(config markTemplateConfig) (*unmarkProjectTemplateMutation, map[string]interface{}) {
+	return &unmarkProjectTemplateMutation{}, map[string]interface{}{
+		"input": UnmarkProjectV2AsTemplateInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+		},
+		"firstItems":  githubv4.Int(0),
+		"afterItems":  (*githubv4.String)(nil),
+		"firstFields": githubv4.Int(0),
+		"afterFields": (*githubv4.String)(nil),
+	}
+}
+
+func printResults(config markTemplateConfig, project queries.Project) error {
+	// using table printer here for consistency in case it ends up being needed in the future
+	if config.opts.undo {
+		config.tp.AddField(fmt.Sprintf("Unmarked project %d as a template", project.Number))
+	} else {
+		config.tp.AddField(fmt.Sprintf("Marked project %d as a template", project.Number))
+	}
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+func printJSON(config markTemplateConfig, project queries.Project) error {
+	b, err := format.JSONProject(project)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
@@ -0,0 +1,185 @@
+package reorder
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/filter"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+type reorderOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	by        []string
+	projectID string
+}
+
+type reorderConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   reorderOpts
+}
+
+func NewCmdReorder(f *cmdutil.Factory, runF func(config reorderConfig) error) *cobra.Command {
+	opts := reorderOpts{}
+	reorderCmd := &cobra.Command{
+		Short: "Reorder the items in a project",
+		Long:  "Reorder the items in a project by moving them to match a sort order. Archived items are left in place.",
+		Use:   "reorder [number]",
+		Example: `
+# order the items of org github's project 1 by priority, then by title
+gh projects reorder 1 --org github --by Priority --by title
+
+# put the most recently due items of the current user's project 1 first
+gh projects reorder 1 --user "@me" --by "Due date:desc"
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+			config := reorderConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runReorder(config)
+		},
+	}
+
+	reorderCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	reorderCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	reorderCmd.Flags().StringArrayVar(&opts.by, "by", nil, "Order the items by `FIELD[:asc|desc]`, where FIELD is a project field or one of title, number, repo and type. Can be repeated.")
+
+	// owner can be a user or an org
+	reorderCmd.MarkFlagsMutuallyExclusive("user", "org")
+
+	_ = reorderCmd.MarkFlagRequired("by")
+
+	return reorderCmd
+}
+
+// runReorder moves the unarchived items of a project so that their positions match the --by sort order.
+// Items that already follow the item before them in that order are not moved.
+func runReorder(config reorderConfig) error {
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	p, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = p.ID
+
+	project, err := queries.ProjectItems(config.client, owner, p.Number, 0)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]filter.SortKey, 0, len(config.opts.by))
+	for _, by := range config.opts.by {
+		key, err := filter.ParseSortKey(by, project.Fields.Nodes)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+
+	items := make([]queries.ProjectItem, 0, len(project.Items.Nodes))
+	for _, i := range project.Items.Nodes {
+		if !i.IsArchived {
+			items = append(items, i)
+		}
+	}
+
+	current := make([]string, 0, len(items))
+	for _, i := range items {
+		current = append(current, i.ID())
+	}
+
+	filter.Sort(items, keys)
+
+	moved := 0
+	for n, i := range items {
+		afterID := ""
+		if n > 0 {
+			afterID = items[n-1].ID()
+		}
+		if previousID(current, i.ID()) == afterID {
+			continue
+		}
+
+		err := queries.MoveItem(config.client, config.opts.projectID, i.ID(), afterID)
+		if err != nil {
+			return fmt.Errorf("moved %d items: %w", moved, err)
+		}
+		current = moveAfter(current, i.ID(), afterID)
+		moved++
+	}
+
+	if moved == 0 {
+		config.tp.AddField("Items are already in order")
+	} else {
+		config.tp.AddField(fmt.Sprintf("Moved %s", format.Pluralize(moved, "item")))
+	}
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+// previousID returns the ID before id in ids, or an empty string if id is first.
+func previousID(ids []string, id string) string {
+	for n, i := range ids {
+		if i == id {
+			if n == 0 {
+				return ""
+			}
+			return ids[n-1]
+		}
+	}
+	return ""
+}
+
+// moveAfter moves id after afterID in ids, or to the front if afterID is empty.
+func moveAfter(ids []string, id string, afterID string) []string {
+	result := make([]string, 0, len(ids))
+	if afterID == "" {
+		result = append(result, id)
+	}
+	for _, i := range ids {
+		if i == id {
+			continue
+		}
+		result = append(result, i)
+		if i == afterID {
+			result = append(result, id)
+		}
+	}
+	return result
+}
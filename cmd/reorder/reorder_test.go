@@ -0,0 +1,176 @@
+package reorder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func mockUserProject() {
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+}
+
+func TestRunReorder(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockUserProject()
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  100,
+				"afterItems":  nil,
+				"firstFields": 100,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "c",
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "charlie",
+									},
+								},
+								{
+									"id":         "z",
+									"isArchived": true,
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "archived",
+									},
+								},
+								{
+									"id": "a",
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "alpha",
+									},
+								},
+								{
+									"id": "b",
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "bravo",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// move alpha to the top, then bravo after it, which leaves charlie in place
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation MoveProjectItem.*","variables":{"input":{"projectId":"project ID","itemId":"a"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemPosition": map[string]interface{}{
+					"clientMutationId": "",
+				},
+			},
+		})
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation MoveProjectItem.*","variables":{"input":{"projectId":"project ID","itemId":"b","afterId":"a"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemPosition": map[string]interface{}{
+					"clientMutationId": "",
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := reorderConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: reorderOpts{
+			userOwner: "monalisa",
+			number:    1,
+			by:        []string{"title"},
+		},
+		client: client,
+	}
+
+	err = runReorder(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Moved 2 items\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestMoveAfter(t *testing.T) {
+	assert.Equal(t, []string{"b", "a", "c"}, moveAfter([]string{"a", "b", "c"}, "b", ""))
+	assert.Equal(t, []string{"b", "c", "a"}, moveAfter([]string{"a", "b", "c"}, "a", "c"))
+	assert.Equal(t, []string{"a", "c", "b"}, moveAfter([]string{"a", "b", "c"}, "c", "a"))
+}
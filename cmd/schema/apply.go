@@ -0,0 +1,413 @@
+package schema
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/queries"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type applyOpts struct {
+	file      string
+	userOwner string
+	orgOwner  string
+	number    int
+	projectID string
+	plan      bool
+	prune     bool
+}
+
+type applyConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   applyOpts
+}
+
+const (
+	createAction = "create"
+	updateAction = "update"
+	deleteAction = "delete"
+)
+
+// fieldChange is a single change required to reconcile a project with a schema.
+// optionIDs are the IDs of the existing options of an updated field by name, which are
+// sent with the options so that the values of the items set to them are kept.
+type fieldChange struct {
+	action    string
+	field     schemaField
+	fieldID   string
+	details   []string
+	optionIDs map[string]string
+}
+
+func NewCmdApply(f *cmdutil.Factory, runF func(config applyConfig) error) *cobra.Command {
+	opts := applyOpts{}
+	applyCmd := &cobra.Command{
+		Short: "Apply a YAML schema to the fields of a project",
+		Use:   "apply <file> [number]",
+		Long: `
+Reconcile the custom fields of a project with a schema created by 'schema export'.
+Missing fields are created and single select options and iterations that differ are updated.
+Fields that are not in the schema are only deleted with --prune.
+
+Options are matched by name and updated in place, so the values of items set to them are kept.
+Updating the iterations of a field replaces all of them, including completed iterations, which clears
+the values of items set to an iteration that is no longer present. Removing an option clears the values of items set to it.`,
+		Example: `
+# preview the changes required to make org github's project 2 match schema.yaml
+gh projects schema apply schema.yaml 2 --org github --plan
+
+# apply schema.yaml to the current user's project 2, deleting fields not in the schema
+gh projects schema apply schema.yaml 2 --user "@me" --prune
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			opts.file = args[0]
+			if len(args) == 2 {
+				opts.number, err = strconv.Atoi(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+			config := applyConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runApply(config)
+		},
+	}
+
+	applyCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	applyCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	applyCmd.Flags().BoolVar(&opts.plan, "plan", false, "Print the changes without applying them.")
+	applyCmd.Flags().BoolVar(&opts.prune, "prune", false, "Delete custom fields that are not in the schema.")
+
+	// owner can be a user or an org
+	applyCmd.MarkFlagsMutuallyExclusive("user", "org")
+
+	return applyCmd
+}
+
+func runApply(config applyConfig) error {
+	b, err := os.ReadFile(config.opts.file)
+	if err != nil {
+		return err
+	}
+
+	var s projectSchema
+	if err := yaml.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid schema file %s: %w", config.opts.file, err)
+	}
+	if err := s.validate(); err != nil {
+		return err
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	fields, err := queries.ProjectFieldsWithDetails(config.client, project.ID)
+	if err != nil {
+		return err
+	}
+
+	changes, err := planChanges(s, fields, config.opts.prune)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.plan || len(changes) == 0 {
+		return printPlan(config, changes)
+	}
+
+	for _, c := range changes {
+		if err := applyChange(config, c); err != nil {
+			return fmt.Errorf("failed to %s field %q: %w", c.action, c.field.Name, err)
+		}
+	}
+
+	return printResults(config, changes)
+}
+
+// planChanges compares the schema with the fields of a project and returns the changes
+// needed to reconcile them, ordered as creates, updates and then deletes.
+func planChanges(s projectSchema, fields []queries.ProjectFieldWithDetails, prune bool) ([]fieldChange, error) {
+	existing := make(map[string]queries.ProjectFieldWithDetails)
+	for _, f := range fields {
+		existing[f.Name()] = f
+	}
+
+	creates := make([]fieldChange, 0)
+	updates := make([]fieldChange, 0)
+	deletes := make([]fieldChange, 0)
+
+	desired := make(map[string]bool)
+	for _, sf := range s.Fields {
+		desired[sf.Name] = true
+
+		f, ok := existing[sf.Name]
+		if !ok {
+			creates = append(creates, fieldChange{action: createAction, field: sf})
+			continue
+		}
+
+		if f.DataType() != sf.DataType {
+			return nil, fmt.Errorf("field %q has data type %s in the project but %s in the schema, delete or rename it before applying", sf.Name, f.DataType(), sf.DataType)
+		}
+
+		var details []string
+		switch sf.DataType {
+		case "SINGLE_SELECT":
+			details = diffOptions(f.OptionDetails(), sf.Options)
+		case "ITERATION":
+			details = diffIterations(f.IterationFieldDetails.Configuration.Duration, sortedIterations(f), *sf.Iterations)
+		}
+		if len(details) > 0 {
+			optionIDs := make(map[string]string)
+			for _, o := range f.OptionDetails() {
+				optionIDs[o.Name] = o.ID
+			}
+			updates = append(updates, fieldChange{action: updateAction, field: sf, fieldID: f.ID(), details: details, optionIDs: optionIDs})
+		}
+	}
+
+	if prune {
+		for _, f := range fields {
+			if desired[f.Name()] || !customDataTypes[f.DataType()] {
+				continue
+			}
+			deletes = append(deletes, fieldChange{
+				action:  deleteAction,
+				field:   schemaField{Name: f.Name(), DataType: f.DataType()},
+				fieldID: f.ID(),
+			})
+		}
+	}
+
+	changes := append(creates, updates...)
+	return append(changes, deletes...), nil
+}
+
+func diffOptions(current []queries.SingleSelectFieldOptionDetails, desired []schemaOption) []string {
+	details := make([]string, 0)
+	currentByName := make(map[string]queries.SingleSelectFieldOptionDetails)
+	for _, o := range current {
+		currentByName[o.Name] = o
+	}
+
+	desiredNames := make(map[string]bool)
+	for _, o := range desired {
+		desiredNames[o.Name] = true
+		c, ok := currentByName[o.Name]
+		if !ok {
+			details = append(details, fmt.Sprintf("add option %q", o.Name))
+			continue
+		}
+		if optionColor(o) != c.Color || o.Description != c.Description {
+			details = append(details, fmt.Sprintf("change option %q", o.Name))
+		}
+	}
+
+	for _, o := range current {
+		if !desiredNames[o.Name] {
+			details = append(details, fmt.Sprintf("remove option %q", o.Name))
+		}
+	}
+
+	if len(details) == 0 {
+		for i, o := range current {
+			if desired[i].Name != o.Name {
+				details = append(details, "reorder options")
+				break
+			}
+		}
+	}
+
+	return details
+}
+
+// diffIterations compares the active and completed iterations of a field with the schema.
+func diffIterations(duration int, current []queries.IterationFieldIteration, desired schemaIterations) []string {
+	details := make([]string, 0)
+	if duration != desired.Duration {
+		details = append(details, fmt.Sprintf("change duration %d to %d", duration, desired.Duration))
+	}
+
+	currentByTitle := make(map[string]queries.IterationFieldIteration)
+	for _, i := range current {
+		currentByTitle[i.Title] = i
+	}
+
+	desiredTitles := make(map[string]bool)
+	for _, i := range desired.Iterations {
+		desiredTitles[i.Title] = true
+		c, ok := currentByTitle[i.Title]
+		if !ok {
+			details = append(details, fmt.Sprintf("add iteration %q", i.Title))
+			continue
+		}
+		if c.StartDate != i.StartDate || c.Duration != i.Duration {
+			details = append(details, fmt.Sprintf("change iteration %q", i.Title))
+		}
+	}
+
+	for _, i := range current {
+		if !desiredTitles[i.Title] {
+			details = append(details, fmt.Sprintf("remove iteration %q", i.Title))
+		}
+	}
+
+	return details
+}
+
+func optionColor(o schemaOption) string {
+	if o.Color == "" {
+		return defaultOptionColor
+	}
+	return o.Color
+}
+
+func applyChange(config applyConfig, c fieldChange) error {
+	switch c.action {
+	case createAction:
+		_, err := queries.CreateField(config.client, createFieldInput(config, c.field))
+		return err
+	case updateAction:
+		_, err := queries.UpdateField(config.client, updateFieldInput(c))
+		return err
+	case deleteAction:
+		_, err := queries.DeleteField(config.client, c.fieldID)
+		return err
+	}
+	return fmt.Errorf("unknown action %s", c.action)
+}
+
+func createFieldInput(config applyConfig, field schemaField) queries.CreateProjectV2FieldInput {
+	return queries.CreateProjectV2FieldInput{
+		ProjectID:              githubv4.ID(config.opts.projectID),
+		DataType:               githubv4.ProjectV2CustomFieldType(field.DataType),
+		Name:                   githubv4.String(field.Name),
+		SingleSelectOptions:    singleSelectOptionsInput(field.Options, nil),
+		IterationConfiguration: iterationConfigurationInput(field.Iterations),
+	}
+}
+
+func updateFieldInput(c fieldChange) queries.UpdateProjectV2FieldInput {
+	return queries.UpdateProjectV2FieldInput{
+		FieldID:                githubv4.ID(c.fieldID),
+		SingleSelectOptions:    singleSelectOptionsInput(c.field.Options, c.optionIDs),
+		IterationConfiguration: iterationConfigurationInput(c.field.Iterations),
+	}
+}
+
+// singleSelectOptionsInput returns the options of a field to create or update. Options without an
+// ID in ids are created, the others are updated in place.
+func singleSelectOptionsInput(options []schemaOption, ids map[string]string) *[]queries.ProjectV2SingleSelectFieldOptionInput {
+	if len(options) == 0 {
+		return nil
+	}
+
+	opts := make([]queries.ProjectV2SingleSelectFieldOptionInput, 0, len(options))
+	for _, o := range options {
+		input := queries.ProjectV2SingleSelectFieldOptionInput{
+			Name:        githubv4.String(o.Name),
+			Color:       githubv4.ProjectV2SingleSelectFieldOptionColor(optionColor(o)),
+			Description: githubv4.String(o.Description),
+		}
+		if id, ok := ids[o.Name]; ok {
+			input.ID = githubv4.NewID(githubv4.ID(id))
+		}
+		opts = append(opts, input)
+	}
+	return &opts
+}
+
+func iterationConfigurationInput(iterations *schemaIterations) *queries.ProjectV2IterationFieldConfigurationInput {
+	if iterations == nil {
+		return nil
+	}
+
+	input := &queries.ProjectV2IterationFieldConfigurationInput{
+		Duration:   githubv4.Int(iterations.Duration),
+		StartDate:  githubv4.String(iterations.StartDate),
+		Iterations: make([]queries.ProjectV2Iteration, 0, len(iterations.Iterations)),
+	}
+	for _, i := range iterations.Iterations {
+		input.Iterations = append(input.Iterations, queries.ProjectV2Iteration{
+			Title:     githubv4.String(i.Title),
+			StartDate: githubv4.String(i.StartDate),
+			Duration:  githubv4.Int(i.Duration),
+		})
+	}
+	return input
+}
+
+func printPlan(config applyConfig, changes []fieldChange) error {
+	if len(changes) == 0 {
+		config.tp.AddField("Project fields already match the schema")
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	addChangeRows(config, changes)
+	return config.tp.Render()
+}
+
+func printResults(config applyConfig, changes []fieldChange) error {
+	addChangeRows(config, changes)
+	config.tp.AddField(fmt.Sprintf("Applied %d changes", len(changes)))
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+func addChangeRows(config applyConfig, changes []fieldChange) {
+	config.tp.AddField("Action")
+	config.tp.AddField("Field")
+	config.tp.AddField("DataType")
+	config.tp.AddField("Details")
+	config.tp.EndRow()
+
+	for _, c := range changes {
+		config.tp.AddField(c.action)
+		config.tp.AddField(c.field.Name)
+		config.tp.AddField(c.field.DataType)
+		if len(c.details) == 0 {
+			config.tp.AddField(" - ")
+		} else {
+			config.tp.AddField(strings.Join(c.details, ", "))
+		}
+		config.tp.EndRow()
+	}
+}
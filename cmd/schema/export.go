@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type exportOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+}
+
+type exportConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   exportOpts
+}
+
+func NewCmdExport(f *cmdutil.Factory, runF func(config exportConfig) error) *cobra.Command {
+	opts := exportOpts{}
+	exportCmd := &cobra.Command{
+		Short: "Export the custom fields of a project as YAML",
+		Use:   "export [number]",
+		Example: `
+# export the schema of the current user's project 1
+gh projects schema export 1 --user "@me"
+
+# export the schema of org github's project 1 to a file
+gh projects schema export 1 --org github > schema.yaml
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+			config := exportConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runExport(config)
+		},
+	}
+
+	exportCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	exportCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+
+	// owner can be a user or an org
+	exportCmd.MarkFlagsMutuallyExclusive("user", "org")
+
+	return exportCmd
+}
+
+func runExport(config exportConfig) error {
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+
+	fields, err := queries.ProjectFieldsWithDetails(config.client, project.ID)
+	if err != nil {
+		return err
+	}
+
+	return printYAML(config, schemaFromFields(fields))
+}
+
+func printYAML(config exportConfig, s projectSchema) error {
+	b, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	// the YAML spans multiple lines, so it must not be truncated to the terminal width
+	config.tp.AddField(strings.TrimSuffix(string(b), "\n"), tableprinter.WithTruncate(nil))
+	config.tp.EndRow()
+	return config.tp.Render()
+}
@@ -0,0 +1,152 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSchema groups the commands that export and apply the field schema of a project.
+func NewCmdSchema(f *cmdutil.Factory) *cobra.Command {
+	schemaCmd := &cobra.Command{
+		Short: "Export and apply the field schema of a project",
+		Use:   "schema",
+		Long: `
+A schema describes the custom fields of a project, including single select options and iterations, as YAML.
+Export the schema of one project and apply it to others to keep their fields consistent.`,
+	}
+
+	schemaCmd.AddCommand(NewCmdExport(f, nil))
+	schemaCmd.AddCommand(NewCmdApply(f, nil))
+
+	return schemaCmd
+}
+
+// projectSchema is the YAML representation of the custom fields of a project.
+type projectSchema struct {
+	Fields []schemaField `yaml:"fields"`
+}
+
+type schemaField struct {
+	Name       string            `yaml:"name"`
+	DataType   string            `yaml:"dataType"`
+	Options    []schemaOption    `yaml:"options,omitempty"`
+	Iterations *schemaIterations `yaml:"iterations,omitempty"`
+}
+
+type schemaOption struct {
+	Name        string `yaml:"name"`
+	Color       string `yaml:"color,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type schemaIterations struct {
+	Duration   int               `yaml:"duration"`
+	StartDate  string            `yaml:"startDate"`
+	Iterations []schemaIteration `yaml:"iterations,omitempty"`
+}
+
+type schemaIteration struct {
+	Title     string `yaml:"title"`
+	StartDate string `yaml:"startDate"`
+	Duration  int    `yaml:"duration"`
+}
+
+const defaultOptionColor = "GRAY"
+
+// customDataTypes are the data types of fields that can be created, as opposed to
+// built-in fields such as Title or Assignees.
+var customDataTypes = map[string]bool{
+	"TEXT":          true,
+	"NUMBER":        true,
+	"DATE":          true,
+	"SINGLE_SELECT": true,
+	"ITERATION":     true,
+}
+
+// schemaFromFields builds a schema from the custom fields of a project.
+func schemaFromFields(fields []queries.ProjectFieldWithDetails) projectSchema {
+	s := projectSchema{Fields: make([]schemaField, 0)}
+	for _, f := range fields {
+		if !customDataTypes[f.DataType()] {
+			continue
+		}
+
+		field := schemaField{
+			Name:     f.Name(),
+			DataType: f.DataType(),
+		}
+		for _, o := range f.OptionDetails() {
+			field.Options = append(field.Options, schemaOption{
+				Name:        o.Name,
+				Color:       o.Color,
+				Description: o.Description,
+			})
+		}
+		if f.DataType() == "ITERATION" {
+			config := f.IterationFieldDetails.Configuration
+			iterations := &schemaIterations{
+				Duration: config.Duration,
+			}
+			for _, i := range sortedIterations(f) {
+				iterations.Iterations = append(iterations.Iterations, schemaIteration{
+					Title:     i.Title,
+					StartDate: i.StartDate,
+					Duration:  i.Duration,
+				})
+			}
+			if len(iterations.Iterations) > 0 {
+				iterations.StartDate = iterations.Iterations[0].StartDate
+			}
+			field.Iterations = iterations
+		}
+
+		s.Fields = append(s.Fields, field)
+	}
+	return s
+}
+
+// sortedIterations returns the active and completed iterations of a field by start date. Completed
+// iterations are part of the schema, as updating the iterations of a field replaces all of them.
+func sortedIterations(f queries.ProjectFieldWithDetails) []queries.IterationFieldIteration {
+	iterations := f.Iterations()
+	sort.SliceStable(iterations, func(i, j int) bool {
+		return iterations[i].StartDate < iterations[j].StartDate
+	})
+	return iterations
+}
+
+// validate checks that a schema only describes fields that can be created.
+func (s projectSchema) validate() error {
+	names := make(map[string]bool)
+	for _, f := range s.Fields {
+		if f.Name == "" {
+			return fmt.Errorf("every field in the schema must have a name")
+		}
+		if names[f.Name] {
+			return fmt.Errorf("field %q is defined more than once", f.Name)
+		}
+		names[f.Name] = true
+
+		if !customDataTypes[f.DataType] {
+			return fmt.Errorf("field %q has data type %q, must be one of TEXT, NUMBER, DATE, SINGLE_SELECT, ITERATION", f.Name, f.DataType)
+		}
+		if f.DataType == "SINGLE_SELECT" && len(f.Options) == 0 {
+			return fmt.Errorf("field %q must have at least one option", f.Name)
+		}
+		if f.DataType == "ITERATION" {
+			if f.Iterations == nil || f.Iterations.Duration == 0 {
+				return fmt.Errorf("field %q must have an iteration duration", f.Name)
+			}
+			if _, err := time.Parse("2006-01-02", f.Iterations.StartDate); err != nil {
+				return fmt.Errorf("field %q must have an iteration startDate in the form YYYY-MM-DD", f.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,477 @@
+package schema
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+const testSchema = `fields:
+    - name: Status
+      dataType: SINGLE_SELECT
+      options:
+        - name: Todo
+          color: GRAY
+        - name: Blocked
+          color: RED
+          description: Waiting on something
+        - name: Done
+          color: GREEN
+    - name: Estimate
+      dataType: NUMBER
+    - name: Sprint
+      dataType: ITERATION
+      iterations:
+        duration: 14
+        startDate: "2022-12-19"
+        iterations:
+            - title: Sprint 0
+              startDate: "2022-12-19"
+              duration: 14
+            - title: Sprint 1
+              startDate: "2023-01-02"
+              duration: 14`
+
+func mockUserLogin() {
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+}
+
+func mockProjectFields() {
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "project ID",
+					},
+				},
+			},
+		})
+
+	// get fields with their options and iterations
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectFieldsWithDetails.*",
+			"variables": map[string]interface{}{
+				"id":    "project ID",
+				"first": 100,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"fields": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"__typename": "ProjectV2Field",
+								"name":       "Title",
+								"id":         "title ID",
+								"dataType":   "TITLE",
+							},
+							{
+								"__typename": "ProjectV2SingleSelectField",
+								"name":       "Status",
+								"id":         "status ID",
+								"dataType":   "SINGLE_SELECT",
+								"options": []map[string]interface{}{
+									{"id": "1", "name": "Todo", "color": "GRAY", "description": ""},
+									{"id": "2", "name": "Done", "color": "GREEN", "description": ""},
+									{"id": "3", "name": "Later", "color": "GRAY", "description": ""},
+								},
+							},
+							{
+								"__typename": "ProjectV2IterationField",
+								"name":       "Sprint",
+								"id":         "sprint ID",
+								"dataType":   "ITERATION",
+								"configuration": map[string]interface{}{
+									"duration": 14,
+									"iterations": []map[string]interface{}{
+										{"id": "i1", "title": "Sprint 1", "startDate": "2023-01-02", "duration": 14},
+									},
+									"completedIterations": []map[string]interface{}{
+										{"id": "i0", "title": "Sprint 0", "startDate": "2022-12-19", "duration": 14},
+									},
+								},
+							},
+							{
+								"__typename": "ProjectV2Field",
+								"name":       "Notes",
+								"id":         "notes ID",
+								"dataType":   "TEXT",
+							},
+						},
+					},
+				},
+			},
+		})
+}
+
+func writeSchema(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "schema.yaml")
+	err := os.WriteFile(path, []byte(testSchema), 0600)
+	assert.NoError(t, err)
+	return path
+}
+
+func TestRunExport(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockUserLogin()
+	mockProjectFields()
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := exportConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: exportOpts{
+			number:    1,
+			userOwner: "monalisa",
+		},
+		client: client,
+	}
+
+	err = runExport(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		`fields:
+    - name: Status
+      dataType: SINGLE_SELECT
+      options:
+        - name: Todo
+          color: GRAY
+        - name: Done
+          color: GREEN
+        - name: Later
+          color: GRAY
+    - name: Sprint
+      dataType: ITERATION
+      iterations:
+        duration: 14
+        startDate: "2022-12-19"
+        iterations:
+            - title: Sprint 0
+              startDate: "2022-12-19"
+              duration: 14
+            - title: Sprint 1
+              startDate: "2023-01-02"
+              duration: 14
+    - name: Notes
+      dataType: TEXT
+`,
+		buf.String())
+}
+
+func TestRunApply_Plan(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockUserLogin()
+	mockProjectFields()
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := applyConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: applyOpts{
+			file:      writeSchema(t),
+			number:    1,
+			userOwner: "monalisa",
+			plan:      true,
+			prune:     true,
+		},
+		client: client,
+	}
+
+	err = runApply(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Action\tField\tDataType\tDetails\n"+
+			"create\tEstimate\tNUMBER\t - \n"+
+			"update\tStatus\tSINGLE_SELECT\tadd option \"Blocked\", remove option \"Later\"\n"+
+			"delete\tNotes\tTEXT\t - \n",
+		buf.String())
+}
+
+func TestRunApply(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockUserLogin()
+	mockProjectFields()
+
+	// create field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateField.*","variables":{"input":{"projectId":"project ID","dataType":"NUMBER","name":"Estimate"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "estimate ID",
+					},
+				},
+			},
+		})
+
+	// update field options
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateField.*","variables":{"input":{"fieldId":"status ID","singleSelectOptions":\[{"id":"1","name":"Todo","color":"GRAY","description":""},{"name":"Blocked","color":"RED","description":"Waiting on something"},{"id":"2","name":"Done","color":"GREEN","description":""}\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "status ID",
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := applyConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: applyOpts{
+			file:      writeSchema(t),
+			number:    1,
+			userOwner: "monalisa",
+		},
+		client: client,
+	}
+
+	err = runApply(config)
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+	assert.Equal(
+		t,
+		"Action\tField\tDataType\tDetails\n"+
+			"create\tEstimate\tNUMBER\t - \n"+
+			"update\tStatus\tSINGLE_SELECT\tadd option \"Blocked\", remove option \"Later\"\n"+
+			"Applied 2 changes\n",
+		buf.String())
+}
+
+func TestRunApply_CompletedIterations(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockUserLogin()
+	mockProjectFields()
+
+	// the completed iteration is sent along with the changed one, so that it is not deleted
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateField.*","variables":{"input":{"fieldId":"sprint ID","iterationConfiguration":{"duration":14,"startDate":"2022-12-19","iterations":\[{"title":"Sprint 0","startDate":"2022-12-19","duration":14},{"title":"Sprint 1","startDate":"2023-01-02","duration":7}\]}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "sprint ID",
+					},
+				},
+			},
+		})
+
+	path := filepath.Join(t.TempDir(), "schema.yaml")
+	err := os.WriteFile(path, []byte(`fields:
+  - name: Sprint
+    dataType: ITERATION
+    iterations:
+      duration: 14
+      startDate: "2022-12-19"
+      iterations:
+        - title: Sprint 0
+          startDate: "2022-12-19"
+          duration: 14
+        - title: Sprint 1
+          startDate: "2023-01-02"
+          duration: 7
+`), 0600)
+	assert.NoError(t, err)
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := applyConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: applyOpts{
+			file:      path,
+			number:    1,
+			userOwner: "monalisa",
+		},
+		client: client,
+	}
+
+	err = runApply(config)
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+	assert.Equal(
+		t,
+		"Action\tField\tDataType\tDetails\n"+
+			"update\tSprint\tITERATION\tchange iteration \"Sprint 1\"\n"+
+			"Applied 1 changes\n",
+		buf.String())
+}
+
+func TestRunApply_RemovesCompletedIteration(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockUserLogin()
+	mockProjectFields()
+
+	path := filepath.Join(t.TempDir(), "schema.yaml")
+	err := os.WriteFile(path, []byte(`fields:
+  - name: Sprint
+    dataType: ITERATION
+    iterations:
+      duration: 14
+      startDate: "2023-01-02"
+      iterations:
+        - title: Sprint 1
+          startDate: "2023-01-02"
+          duration: 14
+`), 0600)
+	assert.NoError(t, err)
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := applyConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: applyOpts{
+			file:      path,
+			number:    1,
+			userOwner: "monalisa",
+			plan:      true,
+		},
+		client: client,
+	}
+
+	err = runApply(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Action\tField\tDataType\tDetails\n"+
+			"update\tSprint\tITERATION\tremove iteration \"Sprint 0\"\n",
+		buf.String())
+}
+
+func TestRunApply_DataTypeConflict(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockUserLogin()
+	mockProjectFields()
+
+	path := filepath.Join(t.TempDir(), "schema.yaml")
+	err := os.WriteFile(path, []byte("fields:\n  - name: Notes\n    dataType: NUMBER\n"), 0600)
+	assert.NoError(t, err)
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	config := applyConfig{
+		tp: tableprinter.New(&bytes.Buffer{}, false, 0),
+		opts: applyOpts{
+			file:      path,
+			number:    1,
+			userOwner: "monalisa",
+		},
+		client: client,
+	}
+
+	err = runApply(config)
+	assert.EqualError(t, err, `field "Notes" has data type TEXT in the project but NUMBER in the schema, delete or rename it before applying`)
+}
+
+func TestSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  projectSchema
+		wantErr string
+	}{
+		{
+			name:    "built-in data type",
+			schema:  projectSchema{Fields: []schemaField{{Name: "Title", DataType: "TITLE"}}},
+			wantErr: `field "Title" has data type "TITLE", must be one of TEXT, NUMBER, DATE, SINGLE_SELECT, ITERATION`,
+		},
+		{
+			name:    "single select without options",
+			schema:  projectSchema{Fields: []schemaField{{Name: "Status", DataType: "SINGLE_SELECT"}}},
+			wantErr: `field "Status" must have at least one option`,
+		},
+		{
+			name:    "duplicate field",
+			schema:  projectSchema{Fields: []schemaField{{Name: "Notes", DataType: "TEXT"}, {Name: "Notes", DataType: "TEXT"}}},
+			wantErr: `field "Notes" is defined more than once`,
+		},
+		{
+			name:    "iteration without start date",
+			schema:  projectSchema{Fields: []schemaField{{Name: "Sprint", DataType: "ITERATION", Iterations: &schemaIterations{Duration: 7}}}},
+			wantErr: `field "Sprint" must have an iteration startDate in the form YYYY-MM-DD`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.schema.validate(), tt.wantErr)
+		})
+	}
+}
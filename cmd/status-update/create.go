@@ -0,0 +1,214 @@
+package statusupdate
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type createOpts struct {
+	userOwner  string
+	orgOwner   string
+	number     int
+	status     string
+	body       string
+	bodyFile   string
+	startDate  string
+	targetDate string
+	projectID  string
+	format     string
+}
+
+type createConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   createOpts
+}
+
+// CreateProjectV2StatusUpdateInput is an input type of CreateProjectV2StatusUpdate.
+type CreateProjectV2StatusUpdateInput struct {
+	ProjectID  githubv4.ID      `json:"projectId"`
+	Body       *githubv4.String `json:"body,omitempty"`
+	StartDate  *githubv4.String `json:"startDate,omitempty"`
+	TargetDate *githubv4.String `json:"targetDate,omitempty"`
+	Status     *githubv4.String `json:"status,omitempty"`
+}
+
+type createStatusUpdateMutation struct {
+	CreateProjectV2StatusUpdate struct {
+		StatusUpdate queries.StatusUpdate `graphql:"statusUpdate"`
+	} `graphql:"createProjectV2StatusUpdate(input:$input)"`
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(config createConfig) error) *cobra.Command {
+	opts := createOpts{}
+	createCmd := &cobra.Command{
+		Short: "Post a status update to a project",
+		Use:   "create [number]",
+		Example: `
+# post an "At risk" status update to org github's project 1 with the body read from report.md
+gh projects status-update create 1 --org github --status AT_RISK --body-file report.md
+
+# post an "On track" status update to the current user's project 1 with start and target dates
+gh projects status-update create 1 --user "@me" --status ON_TRACK --body "All good" --start-date 2023-06-01 --target-date 2023-06-30
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			if opts.bodyFile != "" {
+				b, err := cmdutil.ReadFile(opts.bodyFile, os.Stdin)
+				if err != nil {
+					return err
+				}
+				opts.body = string(b)
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+			config := createConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runCreate(config)
+		},
+	}
+
+	createCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	createCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	createCmd.Flags().StringVar(&opts.status, "status", "", fmt.Sprintf("Status of the project, one of %s.", strings.Join(statusOrder, ", ")))
+	createCmd.Flags().StringVar(&opts.body, "body", "", "Body of the status update in Markdown.")
+	createCmd.Flags().StringVar(&opts.bodyFile, "body-file", "", "Read the body of the status update from a file. Use \"-\" to read from standard input.")
+	createCmd.Flags().StringVar(&opts.startDate, "start-date", "", "Start date of the project, in YYYY-MM-DD format.")
+	createCmd.Flags().StringVar(&opts.targetDate, "target-date", "", "Target date of the project, in YYYY-MM-DD format.")
+	createCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	// owner can be a user or an org
+	createCmd.MarkFlagsMutuallyExclusive("user", "org")
+	createCmd.MarkFlagsMutuallyExclusive("body", "body-file")
+
+	return createCmd
+}
+
+func runCreate(config createConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	config.opts.status = strings.ToUpper(config.opts.status)
+	if _, ok := statuses[config.opts.status]; config.opts.status != "" && !ok {
+		return fmt.Errorf("status must be one of %s", strings.Join(statusOrder, ", "))
+	}
+	if err := validateDate("start", config.opts.startDate); err != nil {
+		return err
+	}
+	if err := validateDate("target", config.opts.targetDate); err != nil {
+		return err
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	query, variables := createStatusUpdateArgs(config)
+	err = config.client.Mutate("CreateProjectStatusUpdate", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printCreateJSON(config, query.CreateProjectV2StatusUpdate.StatusUpdate)
+	}
+
+	return printCreateResults(config, query.CreateProjectV2StatusUpdate.StatusUpdate)
+}
+
+func validateDate(name, date string) error {
+	if date == "" {
+		return nil
+	}
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return fmt.Errorf("invalid %s date %q, must be of the form YYYY-MM-DD", name, date)
+	}
+	return nil
+}
+
+func createStatusUpdateArgs(config createConfig) (*createStatusUpdateMutation, map[string]interface{}) {
+	input := CreateProjectV2StatusUpdateInput{
+		ProjectID: githubv4.ID(config.opts.projectID),
+	}
+	if config.opts.body != "" {
+		input.Body = githubv4.NewString(githubv4.String(config.opts.body))
+	}
+	if config.opts.startDate != "" {
+		input.StartDate = githubv4.NewString(githubv4.String(config.opts.startDate))
+	}
+	if config.opts.targetDate != "" {
+		input.TargetDate = githubv4.NewString(githubv4.String(config.opts.targetDate))
+	}
+	if config.opts.status != "" {
+		input.Status = githubv4.NewString(githubv4.String(config.opts.status))
+	}
+
+	return &createStatusUpdateMutation{}, map[string]interface{}{
+		"input": input,
+	}
+}
+
+func printCreateResults(config createConfig, update queries.StatusUpdate) error {
+	out, err := renderMarkdown([]queries.StatusUpdate{update})
+	if err != nil {
+		return err
+	}
+	// the rendered Markdown spans multiple lines, so it must not be truncated to the terminal width
+	config.tp.AddField(out, tableprinter.WithTruncate(nil))
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+func printCreateJSON(config createConfig, update queries.StatusUpdate) error {
+	b, err := format.JSONStatusUpdate(update)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
@@ -0,0 +1,142 @@
+package statusupdate
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+type listOpts struct {
+	limit     int
+	userOwner string
+	orgOwner  string
+	number    int
+	format    string
+}
+
+type listConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   listOpts
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.Command {
+	opts := listOpts{}
+	listCmd := &cobra.Command{
+		Short: "List the status updates of a project",
+		Use:   "list [number]",
+		Example: `
+# list the status updates of org github's project 1
+gh projects status-update list 1 --org github
+
+# list the last 5 status updates of the current user's project 1
+gh projects status-update list 1 --user "@me" --limit 5
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+			config := listConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runList(config)
+		},
+	}
+
+	listCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	listCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	listCmd.Flags().IntVar(&opts.limit, "limit", 10, "Maximum number of status updates. Must be at most 100.")
+	listCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	// owner can be a user or an org
+	listCmd.MarkFlagsMutuallyExclusive("user", "org")
+
+	return listCmd
+}
+
+func runList(config listConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	if config.opts.limit <= 0 || config.opts.limit > queries.LimitMax {
+		return fmt.Errorf("limit must be between 1 and %d", queries.LimitMax)
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+
+	updates, totalCount, err := queries.ProjectStatusUpdates(config.client, project.ID, config.opts.limit)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printListJSON(config, updates, totalCount)
+	}
+
+	return printListResults(config, project, updates)
+}
+
+func printListResults(config listConfig, project *queries.Project, updates []queries.StatusUpdate) error {
+	if len(updates) == 0 {
+		config.tp.AddField(fmt.Sprintf("Project %d has no status updates", project.Number))
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	out, err := renderMarkdown(updates)
+	if err != nil {
+		return err
+	}
+	// the rendered Markdown spans multiple lines, so it must not be truncated to the terminal width
+	config.tp.AddField(out, tableprinter.WithTruncate(nil))
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+func printListJSON(config listConfig, updates []queries.StatusUpdate, totalCount int) error {
+	b, err := format.JSONStatusUpdates(updates, totalCount)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
@@ -0,0 +1,88 @@
+package statusupdate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdStatusUpdate groups the commands that post and list the status updates of a project.
+func NewCmdStatusUpdate(f *cmdutil.Factory) *cobra.Command {
+	statusUpdateCmd := &cobra.Command{
+		Short: "Post and list the status updates of a project",
+		Use:   "status-update",
+	}
+
+	statusUpdateCmd.AddCommand(NewCmdCreate(f, nil))
+	statusUpdateCmd.AddCommand(NewCmdList(f, nil))
+
+	return statusUpdateCmd
+}
+
+// statuses maps the values of ProjectV2StatusUpdateStatus to the labels shown in the web UI.
+var statuses = map[string]string{
+	"INACTIVE":  "Inactive",
+	"ON_TRACK":  "On track",
+	"AT_RISK":   "At risk",
+	"OFF_TRACK": "Off track",
+	"COMPLETE":  "Complete",
+}
+
+var statusOrder = []string{"INACTIVE", "ON_TRACK", "AT_RISK", "OFF_TRACK", "COMPLETE"}
+
+func statusLabel(status string) string {
+	if label, ok := statuses[status]; ok {
+		return label
+	}
+	return status
+}
+
+// renderMarkdown renders status updates as Markdown for the terminal.
+func renderMarkdown(updates []queries.StatusUpdate) (string, error) {
+	// TODO: respect the glamour env var if set
+	return glamour.Render(statusMarkdown(updates), "dark")
+}
+
+// statusMarkdown formats status updates as a Markdown document, one section per update.
+func statusMarkdown(updates []queries.StatusUpdate) string {
+	var sb strings.Builder
+	for i, u := range updates {
+		if i > 0 {
+			sb.WriteString("\n---\n\n")
+		}
+
+		sb.WriteString(fmt.Sprintf("# %s\n", statusLabel(u.Status)))
+		sb.WriteString(fmt.Sprintf("_%s on %s_\n\n", u.Creator.Login, datePart(u.CreatedAt)))
+
+		if u.StartDate != "" || u.TargetDate != "" {
+			sb.WriteString(fmt.Sprintf("**Start date:** %s  \n", orDashes(u.StartDate)))
+			sb.WriteString(fmt.Sprintf("**Target date:** %s\n\n", orDashes(u.TargetDate)))
+		}
+
+		if u.Body == "" {
+			sb.WriteString(" -- ")
+		} else {
+			sb.WriteString(u.Body)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// datePart trims the time from an ISO 8601 timestamp.
+func datePart(timestamp string) string {
+	date, _, _ := strings.Cut(timestamp, "T")
+	return date
+}
+
+func orDashes(s string) string {
+	if s == "" {
+		return " -- "
+	}
+	return s
+}
@@ -0,0 +1,334 @@
+package statusupdate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/github/gh-projects/queries"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func mockCreateStatusUpdate() {
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateProjectStatusUpdate.*","variables":{"input":{"projectId":"project ID","body":"Waiting on review","startDate":"2023-06-01","status":"AT_RISK"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2StatusUpdate": map[string]interface{}{
+					"statusUpdate": map[string]interface{}{
+						"id":        "update ID",
+						"body":      "Waiting on review",
+						"status":    "AT_RISK",
+						"startDate": "2023-06-01",
+						"createdAt": "2023-06-12T10:00:00Z",
+						"creator": map[string]interface{}{
+							"login": "monalisa",
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestRunCreate(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+	mockCreateStatusUpdate()
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := createConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: createOpts{
+			orgOwner:  "github",
+			number:    1,
+			status:    "at_risk",
+			body:      "Waiting on review",
+			startDate: "2023-06-01",
+		},
+		client: client,
+	}
+
+	err = runCreate(config)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "monalisa on 2023-06-12")
+}
+
+func TestRunCreate_JSON(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+	mockCreateStatusUpdate()
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := createConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: createOpts{
+			orgOwner:  "github",
+			number:    1,
+			status:    "AT_RISK",
+			body:      "Waiting on review",
+			startDate: "2023-06-01",
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runCreate(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"id":"update ID","status":"AT_RISK","body":"Waiting on review","startDate":"2023-06-01","createdAt":"2023-06-12T10:00:00Z","creator":"monalisa"}`,
+		buf.String())
+}
+
+func TestRunCreate_InvalidInput(t *testing.T) {
+	config := createConfig{
+		tp: tableprinter.New(&bytes.Buffer{}, false, 0),
+		opts: createOpts{
+			orgOwner: "github",
+			number:   1,
+			status:   "late",
+		},
+	}
+	err := runCreate(config)
+	assert.EqualError(t, err, "status must be one of INACTIVE, ON_TRACK, AT_RISK, OFF_TRACK, COMPLETE")
+
+	config.opts.status = "ON_TRACK"
+	config.opts.targetDate = "06/30/2023"
+	err = runCreate(config)
+	assert.EqualError(t, err, `invalid target date "06/30/2023", must be of the form YYYY-MM-DD`)
+}
+
+func TestRunList(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectStatusUpdates.*",
+			"variables": map[string]interface{}{
+				"id":    "project ID",
+				"first": 10,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"statusUpdates": map[string]interface{}{
+						"totalCount": 2,
+						"nodes": []map[string]interface{}{
+							{
+								"id":         "second ID",
+								"body":       "Shipped",
+								"status":     "COMPLETE",
+								"targetDate": "2023-06-30",
+								"createdAt":  "2023-06-30T10:00:00Z",
+								"creator":    map[string]interface{}{"login": "monalisa"},
+							},
+							{
+								"id":        "first ID",
+								"body":      "Waiting on review",
+								"status":    "AT_RISK",
+								"createdAt": "2023-06-12T10:00:00Z",
+								"creator":   map[string]interface{}{"login": "hubot"},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			orgOwner: "github",
+			number:   1,
+			limit:    10,
+			format:   "json",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"statusUpdates":[{"id":"second ID","status":"COMPLETE","body":"Shipped","targetDate":"2023-06-30","createdAt":"2023-06-30T10:00:00Z","creator":"monalisa"},{"id":"first ID","status":"AT_RISK","body":"Waiting on review","createdAt":"2023-06-12T10:00:00Z","creator":"hubot"}],"totalCount":2}`,
+		buf.String())
+}
+
+func TestStatusMarkdown(t *testing.T) {
+	first := queries.StatusUpdate{
+		Body:       "Shipped",
+		Status:     "COMPLETE",
+		TargetDate: "2023-06-30",
+		CreatedAt:  "2023-06-30T10:00:00Z",
+	}
+	first.Creator.Login = "monalisa"
+	second := queries.StatusUpdate{
+		Status:    "AT_RISK",
+		CreatedAt: "2023-06-12T10:00:00Z",
+	}
+	second.Creator.Login = "hubot"
+
+	assert.Equal(
+		t,
+		"# Complete\n_monalisa on 2023-06-30_\n\n**Start date:**  --   \n**Target date:** 2023-06-30\n\nShipped\n"+
+			"\n---\n\n"+
+			"# At risk\n_hubot on 2023-06-12_\n\n -- \n",
+		statusMarkdown([]queries.StatusUpdate{first, second}))
+}
@@ -0,0 +1,275 @@
+package projectsync
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+type syncOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	query     string
+	archive   bool
+	dryRun    bool
+	projectID string
+	format    string
+}
+
+type syncConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   syncOpts
+}
+
+const (
+	added      = "added"
+	unarchived = "unarchived"
+	archived   = "archived"
+)
+
+func NewCmdSync(f *cmdutil.Factory, runF func(config syncConfig) error) *cobra.Command {
+	opts := syncOpts{}
+	syncCmd := &cobra.Command{
+		Short: "Sync the items of a project with a search",
+		Long: `Sync the items of a project with a search for issues and pull requests.
+
+The issues and pull requests matching --query that are not in the project are added, and the
+ones that were archived are unarchived. With --archive, the issues and pull requests of the
+project that no longer match are archived. Draft issues are left alone.
+
+Running sync again without changes on GitHub makes no changes, so it can be run on a schedule.
+The query uses the GitHub search syntax, which returns at most 1000 results.`,
+		Use: "sync [number]",
+		Example: `
+# keep all the open P1 bugs of org github in its project 1
+gh projects sync 1 --org github --query "org:github is:open label:bug label:P1" --archive
+
+# list the changes that would be made to the current user's project 1, without making them
+gh projects sync 1 --user "@me" --query "repo:cli/go-gh is:open" --dry-run
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+			config := syncConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runSync(config)
+		},
+	}
+
+	syncCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	syncCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	syncCmd.Flags().StringVar(&opts.query, "query", "", "Search for the issues and pull requests the project must contain, such as \"org:github is:open label:bug\".")
+	syncCmd.Flags().BoolVar(&opts.archive, "archive", false, "Archive the issues and pull requests of the project that do not match --query.")
+	syncCmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the changes without making them.")
+	syncCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	// owner can be a user or an org
+	syncCmd.MarkFlagsMutuallyExclusive("user", "org")
+
+	_ = syncCmd.MarkFlagRequired("query")
+
+	return syncCmd
+}
+
+func runSync(config syncConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	p, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = p.ID
+
+	project, err := queries.ProjectItems(config.client, owner, p.Number, 0)
+	if err != nil {
+		return err
+	}
+
+	matches, total, err := queries.SearchItems(config.client, config.opts.query)
+	if err != nil {
+		return err
+	}
+	// items missing from a truncated search would be archived although they match
+	if config.opts.archive && total > len(matches) {
+		return fmt.Errorf("the query matches %d items but the search returns at most %d, narrow --query to use --archive", total, queries.SearchLimit)
+	}
+
+	report, toUnarchive, toArchive := planSync(config, project.Items.Nodes, matches)
+
+	if !config.opts.dryRun {
+		err = applySync(config, report, toUnarchive, toArchive)
+		if err != nil {
+			return err
+		}
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config, report)
+	}
+
+	return printResults(config, report)
+}
+
+// planSync compares the items of a project with the items matching the search. It returns the
+// changes to make, along with the IDs of the project items to unarchive and to archive.
+func planSync(config syncConfig, items []queries.ProjectItem, matches []queries.SearchItem) (format.SyncReport, []string, []string) {
+	report := format.SyncReport{DryRun: config.opts.dryRun, Changes: make([]format.SyncChange, 0)}
+	toUnarchive := make([]string, 0)
+	toArchive := make([]string, 0)
+
+	// draft issues have no URL and are never matched
+	byURL := make(map[string]queries.ProjectItem, len(items))
+	for _, i := range items {
+		if url := i.URL(); url != "" {
+			byURL[url] = i
+		}
+	}
+
+	matched := make(map[string]bool, len(matches))
+	for _, m := range matches {
+		matched[m.URL] = true
+		item, ok := byURL[m.URL]
+		switch {
+		case !ok:
+			report.Changes = append(report.Changes, format.SyncChange{Item: m.Reference(), Title: m.Title, URL: m.URL, Change: added, ContentID: m.ID})
+		case item.IsArchived:
+			report.Changes = append(report.Changes, format.SyncChange{Item: m.Reference(), Title: m.Title, URL: m.URL, Change: unarchived})
+			toUnarchive = append(toUnarchive, item.ID())
+		default:
+			report.Unchanged++
+		}
+	}
+
+	if !config.opts.archive {
+		return report, toUnarchive, toArchive
+	}
+
+	for _, i := range items {
+		if i.URL() == "" || i.IsArchived || matched[i.URL()] {
+			continue
+		}
+		report.Changes = append(report.Changes, format.SyncChange{
+			Item:   fmt.Sprintf("%s#%d", i.Repo(), i.Number()),
+			Title:  i.Title(),
+			URL:    i.URL(),
+			Change: archived,
+		})
+		toArchive = append(toArchive, i.ID())
+	}
+	return report, toUnarchive, toArchive
+}
+
+// applySync makes the changes of a report. It stops at the first error; as sync only makes the
+// changes still needed, running it again picks up where it stopped.
+func applySync(config syncConfig, report format.SyncReport, toUnarchive []string, toArchive []string) error {
+	n := 0
+	for _, c := range report.Changes {
+		if c.Change != added {
+			continue
+		}
+		_, err := queries.AddProjectItem(config.client, config.opts.projectID, c.ContentID)
+		if err != nil {
+			return fmt.Errorf("added %s, could not add %s: %w", format.Pluralize(n, "item"), c.Item, err)
+		}
+		n++
+	}
+
+	done, err := queries.BulkItemMutation(config.client, "UnarchiveProjectItems", "unarchiveProjectV2Item", config.opts.projectID, toUnarchive)
+	if err != nil {
+		return fmt.Errorf("unarchived %d of %d items: %w", done, len(toUnarchive), err)
+	}
+
+	done, err = queries.BulkItemMutation(config.client, "ArchiveProjectItems", "archiveProjectV2Item", config.opts.projectID, toArchive)
+	if err != nil {
+		return fmt.Errorf("archived %d of %d items: %w", done, len(toArchive), err)
+	}
+	return nil
+}
+
+func printResults(config syncConfig, report format.SyncReport) error {
+	if len(report.Changes) == 0 {
+		config.tp.AddField(fmt.Sprintf("Project is in sync, %s match the query", format.Pluralize(report.Unchanged, "item")))
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	config.tp.AddField("Change")
+	config.tp.AddField("Item")
+	config.tp.AddField("Title")
+	config.tp.EndRow()
+
+	counts := map[string]int{}
+	for _, c := range report.Changes {
+		// a dry run lists the changes that would be made
+		change := c.Change
+		if report.DryRun {
+			change = "would be " + change
+		}
+		config.tp.AddField(change)
+		config.tp.AddField(c.Item)
+		config.tp.AddField(c.Title)
+		config.tp.EndRow()
+		counts[c.Change]++
+	}
+
+	summary := make([]string, 0)
+	for _, change := range []string{added, unarchived, archived} {
+		if counts[change] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[change], change))
+		}
+	}
+	summary = append(summary, fmt.Sprintf("%d unchanged", report.Unchanged))
+	config.tp.AddField(strings.Join(summary, ", "))
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+func printJSON(config syncConfig, report format.SyncReport) error {
+	b, err := format.JSONSyncReport(report)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
@@ -0,0 +1,308 @@
+package projectsync
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// mockProject mocks the queries for the org github's project 1, which holds issue 1, archived
+// issue 2, issue 3 and a draft issue.
+func mockProject() {
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  100,
+				"afterItems":  nil,
+				"firstFields": 100,
+				"afterFields": nil,
+				"login":       "github",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "item 1",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "crash on start",
+										"number":     1,
+										"url":        "https://github.com/cli/go-gh/issues/1",
+										"repository": map[string]interface{}{"nameWithOwner": "cli/go-gh"},
+									},
+								},
+								{
+									"id":         "item 2",
+									"isArchived": true,
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "slow startup",
+										"number":     2,
+										"url":        "https://github.com/cli/go-gh/issues/2",
+										"repository": map[string]interface{}{"nameWithOwner": "cli/go-gh"},
+									},
+								},
+								{
+									"id": "item 3",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "typo in docs",
+										"number":     3,
+										"url":        "https://github.com/cli/go-gh/issues/3",
+										"repository": map[string]interface{}{"nameWithOwner": "cli/go-gh"},
+									},
+								},
+								{
+									"id": "item 4",
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "plan release",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+}
+
+// mockSearch mocks a search matching issues 1 and 2, and pull request 5 which is not in the project.
+func mockSearch(issueCount int) {
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query SearchItems.*",
+			"variables": map[string]interface{}{
+				"query": "repo:cli/go-gh is:open label:bug",
+				"first": 100,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"search": map[string]interface{}{
+					"issueCount": issueCount,
+					"nodes": []map[string]interface{}{
+						{
+							"__typename": "Issue",
+							"id":         "issue 1",
+							"number":     1,
+							"title":      "crash on start",
+							"url":        "https://github.com/cli/go-gh/issues/1",
+							"repository": map[string]interface{}{"nameWithOwner": "cli/go-gh"},
+						},
+						{
+							"__typename": "Issue",
+							"id":         "issue 2",
+							"number":     2,
+							"title":      "slow startup",
+							"url":        "https://github.com/cli/go-gh/issues/2",
+							"repository": map[string]interface{}{"nameWithOwner": "cli/go-gh"},
+						},
+						{
+							"__typename": "PullRequest",
+							"id":         "pull request 5",
+							"number":     5,
+							"title":      "fix crash on start",
+							"url":        "https://github.com/cli/go-gh/pull/5",
+							"repository": map[string]interface{}{"nameWithOwner": "cli/go-gh"},
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestRunSync(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockProject()
+	mockSearch(3)
+
+	// add the pull request
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"project ID","contentId":"pull request 5"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item 5",
+					},
+				},
+			},
+		})
+
+	// unarchive issue 2
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UnarchiveProjectItems.*item0: unarchiveProjectV2Item.*","variables":{"item0":"item 2","projectId":"project ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"item0": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+			},
+		})
+
+	// archive issue 3, which no longer matches
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation ArchiveProjectItems.*item0: archiveProjectV2Item.*","variables":{"item0":"item 3","projectId":"project ID"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"item0": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := syncConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: syncOpts{
+			orgOwner: "github",
+			number:   1,
+			query:    "repo:cli/go-gh is:open label:bug",
+			archive:  true,
+		},
+		client: client,
+	}
+
+	err = runSync(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Change\tItem\tTitle\nunarchived\tcli/go-gh#2\tslow startup\nadded\tcli/go-gh#5\tfix crash on start\narchived\tcli/go-gh#3\ttypo in docs\n1 added, 1 unarchived, 1 archived, 1 unchanged\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunSync_DryRunJSON(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockProject()
+	mockSearch(3)
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := syncConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: syncOpts{
+			orgOwner: "github",
+			number:   1,
+			query:    "repo:cli/go-gh is:open label:bug",
+			dryRun:   true,
+			format:   "json",
+		},
+		client: client,
+	}
+
+	err = runSync(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"dryRun":true,"changes":[{"item":"cli/go-gh#2","title":"slow startup","url":"https://github.com/cli/go-gh/issues/2","change":"unarchived"},{"item":"cli/go-gh#5","title":"fix crash on start","url":"https://github.com/cli/go-gh/pull/5","change":"added"}],"unchanged":1}`,
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunSync_TruncatedSearchArchive(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	mockProject()
+	mockSearch(1500)
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := syncConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: syncOpts{
+			orgOwner: "github",
+			number:   1,
+			query:    "repo:cli/go-gh is:open label:bug",
+			archive:  true,
+		},
+		client: client,
+	}
+
+	err = runSync(config)
+	assert.EqualError(t, err, "the query matches 1500 items but the search returns at most 1000, narrow --query to use --archive")
+	assert.True(t, gock.IsDone())
+}
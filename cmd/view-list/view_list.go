@@ -0,0 +1,147 @@
+package viewlist
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+type listOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	format    string
+}
+
+type listConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   listOpts
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.Command {
+	opts := listOpts{}
+	listCmd := &cobra.Command{
+		Short: "List the views in a project",
+		Use:   "view-list [number]",
+		Example: `
+# list the views in the current user's project 1
+gh projects view-list 1 --user "@me"
+
+# list the views in org github's project 1
+gh projects view-list 1 --org github
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+			config := listConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runList(config)
+		},
+	}
+
+	listCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	listCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	listCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	// owner can be a user or an org
+	listCmd.MarkFlagsMutuallyExclusive("user", "org")
+
+	return listCmd
+}
+
+func runList(config listConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+
+	views, err := queries.ProjectViews(config.client, project.ID)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config, views)
+	}
+
+	return printResults(config, views, project.Number)
+}
+
+func printResults(config listConfig, views []queries.ProjectView, number int) error {
+	if len(views) == 0 {
+		config.tp.AddField(fmt.Sprintf("Project %d has no views", number))
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	config.tp.AddField("Number")
+	config.tp.AddField("Name")
+	config.tp.AddField("Layout")
+	config.tp.AddField("Filter")
+	config.tp.EndRow()
+
+	for _, v := range views {
+		config.tp.AddField(strconv.Itoa(v.Number))
+		config.tp.AddField(v.Name)
+		config.tp.AddField(format.ViewLayout(v.Layout))
+		if v.Filter == "" {
+			config.tp.AddField(" - ")
+		} else {
+			config.tp.AddField(v.Filter)
+		}
+		config.tp.EndRow()
+	}
+
+	return config.tp.Render()
+}
+
+func printJSON(config listConfig, views []queries.ProjectView) error {
+	b, err := format.JSONProjectViews(views)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
@@ -0,0 +1,262 @@
+package viewlist
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRunList(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+	field := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"__typename": "ProjectV2Field",
+			"name":       name,
+		}
+	}
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectViews.*",
+			"variables": map[string]interface{}{
+				"id": "project ID",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"views": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"id":     "table ID",
+								"name":   "Backlog",
+								"number": 1,
+								"layout": "TABLE_LAYOUT",
+								"fields": map[string]interface{}{
+									"nodes": []map[string]interface{}{field("Title"), field("Status")},
+								},
+							},
+							{
+								"id":     "board ID",
+								"name":   "Sprint board",
+								"number": 2,
+								"layout": "BOARD_LAYOUT",
+								"filter": "is:open label:bug",
+								"fields": map[string]interface{}{
+									"nodes": []map[string]interface{}{field("Title")},
+								},
+								"verticalGroupByFields": map[string]interface{}{
+									"nodes": []map[string]interface{}{field("Status")},
+								},
+								"sortByFields": map[string]interface{}{
+									"nodes": []map[string]interface{}{
+										{"direction": "DESC", "field": field("Points")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			orgOwner: "github",
+			number:   1,
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Number\tName\tLayout\tFilter\n1\tBacklog\tTable\t - \n2\tSprint board\tBoard\tis:open label:bug\n",
+		buf.String())
+}
+
+func TestRunList_JSON(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get org project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+	field := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"__typename": "ProjectV2Field",
+			"name":       name,
+		}
+	}
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectViews.*",
+			"variables": map[string]interface{}{
+				"id": "project ID",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"views": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"id":     "table ID",
+								"name":   "Backlog",
+								"number": 1,
+								"layout": "TABLE_LAYOUT",
+								"fields": map[string]interface{}{
+									"nodes": []map[string]interface{}{field("Title"), field("Status")},
+								},
+							},
+							{
+								"id":     "board ID",
+								"name":   "Sprint board",
+								"number": 2,
+								"layout": "BOARD_LAYOUT",
+								"filter": "is:open label:bug",
+								"fields": map[string]interface{}{
+									"nodes": []map[string]interface{}{field("Title")},
+								},
+								"verticalGroupByFields": map[string]interface{}{
+									"nodes": []map[string]interface{}{field("Status")},
+								},
+								"sortByFields": map[string]interface{}{
+									"nodes": []map[string]interface{}{
+										{"direction": "DESC", "field": field("Points")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := listConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: listOpts{
+			orgOwner: "github",
+			number:   1,
+			format:   "json",
+		},
+		client: client,
+	}
+
+	err = runList(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"views":[{"id":"table ID","name":"Backlog","number":1,"layout":"TABLE_LAYOUT","filter":"","fields":["Title","Status"],"groupBy":[],"verticalGroupBy":[],"sortBy":[]},{"id":"board ID","name":"Sprint board","number":2,"layout":"BOARD_LAYOUT","filter":"is:open label:bug","fields":["Title"],"groupBy":[],"verticalGroupBy":["Status"],"sortBy":[{"field":"Points","direction":"DESC"}]}],"totalCount":2}`,
+		buf.String())
+}
@@ -0,0 +1,166 @@
+package viewshow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+type showOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	view      string
+	format    string
+}
+
+type showConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   showOpts
+}
+
+func NewCmdShow(f *cmdutil.Factory, runF func(config showConfig) error) *cobra.Command {
+	opts := showOpts{}
+	showCmd := &cobra.Command{
+		Short: "Show the layout, filter, grouping and sorting of a project view",
+		Use:   "view-show [number]",
+		Example: `
+# show the view named "Sprint board" in org github's project 1
+gh projects view-show 1 --org github --view "Sprint board"
+
+# show view number 2 in the current user's project 1
+gh projects view-show 1 --user "@me" --view 2
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+			config := showConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runShow(config)
+		},
+	}
+
+	showCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	showCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	showCmd.Flags().StringVar(&opts.view, "view", "", "Name or number of the view.")
+	showCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	// owner can be a user or an org
+	showCmd.MarkFlagsMutuallyExclusive("user", "org")
+	_ = showCmd.MarkFlagRequired("view")
+
+	return showCmd
+}
+
+func runShow(config showConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+
+	views, err := queries.ProjectViews(config.client, project.ID)
+	if err != nil {
+		return err
+	}
+
+	view, err := queries.FindProjectView(views, config.opts.view)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config, *view)
+	}
+
+	return printResults(config, *view)
+}
+
+func printResults(config showConfig, view queries.ProjectView) error {
+	sortBy := make([]string, 0, len(view.SortByFields.Nodes))
+	for _, s := range view.SortByFields.Nodes {
+		sortBy = append(sortBy, fmt.Sprintf("%s (%s)", s.Field.Name(), strings.ToLower(s.Direction)))
+	}
+
+	rows := [][2]string{
+		{"Name", view.Name},
+		{"Number", strconv.Itoa(view.Number)},
+		{"Layout", format.ViewLayout(view.Layout)},
+		{"Filter", view.Filter},
+		{"Group by", fieldNames(view.GroupByFields.Nodes)},
+		{"Vertical group by", fieldNames(view.VerticalGroupByFields.Nodes)},
+		{"Sort by", strings.Join(sortBy, ", ")},
+		{"Fields", fieldNames(view.Fields.Nodes)},
+	}
+
+	for _, r := range rows {
+		config.tp.AddField(r[0])
+		if r[1] == "" {
+			config.tp.AddField(" - ")
+		} else {
+			config.tp.AddField(r[1])
+		}
+		config.tp.EndRow()
+	}
+
+	return config.tp.Render()
+}
+
+func fieldNames(fields []queries.ProjectField) string {
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, f.Name())
+	}
+	return strings.Join(names, ", ")
+}
+
+func printJSON(config showConfig, view queries.ProjectView) error {
+	b, err := format.JSONProjectView(view)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
@@ -123,11 +123,16 @@ func runView(config viewConfig) error {
 		return err
 	}
 
+	repos, teams, err := queries.ProjectLinks(config.client, project.ID)
+	if err != nil {
+		return err
+	}
+
 	if config.opts.format == "json" {
-		return printJSON(config, *project)
+		return printJSON(config, *project, repos, teams)
 	}
 
-	return printResults(config, project)
+	return printResults(config, project, repos, teams)
 }
 
 func buildURL(config viewConfig) (string, error) {
@@ -147,7 +152,7 @@ func buildURL(config viewConfig) (string, error) {
 	return url, nil
 }
 
-func printResults(config viewConfig, project *queries.Project) error {
+func printResults(config viewConfig, project *queries.Project, repos []string, teams []string) error {
 
 	var sb strings.Builder
 	sb.WriteString("# Title\n")
@@ -186,6 +191,22 @@ func printResults(config viewConfig, project *queries.Project) error {
 	}
 	sb.WriteString("\n")
 
+	sb.WriteString("## Linked repositories\n")
+	if len(repos) == 0 {
+		sb.WriteString(" -- ")
+	} else {
+		sb.WriteString(strings.Join(repos, ", "))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Linked teams\n")
+	if len(teams) == 0 {
+		sb.WriteString(" -- ")
+	} else {
+		sb.WriteString(strings.Join(teams, ", "))
+	}
+	sb.WriteString("\n")
+
 	sb.WriteString("## Field Name (Field Type)\n")
 	for _, f := range project.Fields.Nodes {
 		sb.WriteString(fmt.Sprintf("%s (%s)\n\n", f.Name(), f.Type()))
@@ -201,8 +222,8 @@ func printResults(config viewConfig, project *queries.Project) error {
 	return config.tp.Render()
 }
 
-func printJSON(config viewConfig, project queries.Project) error {
-	b, err := format.JSONProject(project)
+func printJSON(config viewConfig, project queries.Project, repos []string, teams []string) error {
+	b, err := format.JSONProjectWithLinks(project, repos, teams)
 	if err != nil {
 		return err
 	}
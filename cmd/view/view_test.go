@@ -111,6 +111,32 @@ func TestRunView_User(t *testing.T) {
 			}
 		`)
 
+	// get linked repositories and teams
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectLinks.*",
+			"variables": map[string]interface{}{
+				"id": "",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"repositories": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"nameWithOwner": "cli/go-gh"},
+						},
+					},
+					"teams": map[string]interface{}{
+						"nodes": []map[string]interface{}{},
+					},
+				},
+			},
+		})
+
 	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
 	assert.NoError(t, err)
 
@@ -126,6 +152,7 @@ func TestRunView_User(t *testing.T) {
 
 	err = runView(config)
 	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "cli/go-gh")
 
 }
 
@@ -175,6 +202,32 @@ func TestRunView_Viewer(t *testing.T) {
 			}
 		`)
 
+	// get linked repositories and teams
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectLinks.*",
+			"variables": map[string]interface{}{
+				"id": "",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"repositories": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"nameWithOwner": "cli/go-gh"},
+						},
+					},
+					"teams": map[string]interface{}{
+						"nodes": []map[string]interface{}{},
+					},
+				},
+			},
+		})
+
 	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
 	assert.NoError(t, err)
 
@@ -241,6 +294,32 @@ func TestRunView_Org(t *testing.T) {
 			}
 		`)
 
+	// get linked repositories and teams
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectLinks.*",
+			"variables": map[string]interface{}{
+				"id": "",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"repositories": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"nameWithOwner": "cli/go-gh"},
+						},
+					},
+					"teams": map[string]interface{}{
+						"nodes": []map[string]interface{}{},
+					},
+				},
+			},
+		})
+
 	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
 	assert.NoError(t, err)
 
@@ -258,6 +337,104 @@ func TestRunView_Org(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRunView_JSON(t *testing.T) {
+	defer gock.Off()
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserLogin.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(`
+			{"data":
+				{"user":
+					{
+						"login":"monalisa",
+						"projectV2": {
+							"number": 1,
+							"items": {
+								"totalCount": 10
+							},
+							"readme": null,
+							"fields": {
+								"nodes": [
+									{
+										"name": "Title"
+									}
+								]
+							}
+						}
+					}
+				}
+			}
+		`)
+
+	// get linked repositories and teams
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectLinks.*",
+			"variables": map[string]interface{}{
+				"id": "",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"repositories": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"nameWithOwner": "cli/go-gh"},
+						},
+					},
+					"teams": map[string]interface{}{
+						"nodes": []map[string]interface{}{},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := viewConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: viewOpts{
+			userOwner: "monalisa",
+			number:    1,
+			format:    "json",
+		},
+		client: client,
+	}
+
+	err = runView(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"number":1,"url":"","shortDescription":"","public":false,"closed":false,"title":"","id":"","readme":"","items":{"totalCount":10},"fields":{"totalCount":0},"owner":{"type":"","login":""},"repositories":["cli/go-gh"],"teams":[]}`,
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
 func TestRunViewWeb(t *testing.T) {
 	buf := bytes.Buffer{}
 	config := viewConfig{
@@ -0,0 +1,330 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/filter"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+// minInterval keeps polling within the API rate limits, as each poll reads all the items of the project.
+const minInterval = 10 * time.Second
+
+type watchOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	interval  time.Duration
+	query     string
+	fields    []string
+	format    string
+}
+
+type watchConfig struct {
+	// out receives the events as they happen. A table printer is not used, as it
+	// keeps its rows after rendering and would print earlier events again.
+	out    io.Writer
+	client *api.GraphQLClient
+	opts   watchOpts
+	errOut io.Writer
+	// wait waits for the next poll, it returns false to stop watching
+	wait func(time.Duration) bool
+	now  func() time.Time
+}
+
+const (
+	added      = "added"
+	removed    = "removed"
+	archived   = "archived"
+	unarchived = "unarchived"
+	changed    = "changed"
+)
+
+func NewCmdWatch(f *cmdutil.Factory, runF func(config watchConfig) error) *cobra.Command {
+	opts := watchOpts{}
+	watchCmd := &cobra.Command{
+		Short: "Watch a project for changes",
+		Long: `Watch a project for changes to its items, such as added items or changed field values.
+
+The project is polled every --interval, and a line is printed for each change since the last poll,
+or a JSON object per line with --format=json. Watching stops with Ctrl+C.`,
+		Use: "watch [number]",
+		Example: `
+# print the changes to org github's project 1 every minute
+gh projects watch 1 --org github
+
+# print the status changes of the bugs of the current user's project 1 every 30 seconds
+gh projects watch 1 --user "@me" --query "label:bug" --field Status --interval 30s
+
+# add --format=json to output in JSON Lines format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			config := watchConfig{
+				out:    terminal.Out(),
+				client: client,
+				opts:   opts,
+				errOut: terminal.ErrOut(),
+				wait: func(d time.Duration) bool {
+					time.Sleep(d)
+					return true
+				},
+				now: time.Now,
+			}
+			return runWatch(config)
+		},
+	}
+
+	watchCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	watchCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	watchCmd.Flags().DurationVar(&opts.interval, "interval", time.Minute, "Time between polls, such as 30s or 5m. Must be at least 10s.")
+	watchCmd.Flags().StringVar(&opts.query, "query", "", "Only report changes to the items matching a filter, such as \"label:bug assignee:monalisa\".")
+	watchCmd.Flags().StringArrayVar(&opts.fields, "field", nil, "Only report value changes of this field. Can be repeated.")
+	watchCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	// owner can be a user or an org
+	watchCmd.MarkFlagsMutuallyExclusive("user", "org")
+
+	return watchCmd
+}
+
+func runWatch(config watchConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+	if config.opts.interval < minInterval {
+		return fmt.Errorf("interval must be at least %s", minInterval)
+	}
+
+	var f *filter.Filter
+	if config.opts.query != "" {
+		var err error
+		f, err = filter.Parse(config.opts.query)
+		if err != nil {
+			return err
+		}
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+
+	// the first poll is the snapshot the changes are reported against
+	previous, err := queries.ProjectItems(config.client, owner, project.Number, 0)
+	if err != nil {
+		return err
+	}
+	err = checkFields(previous.Fields.Nodes, config.opts.fields)
+	if err != nil {
+		return err
+	}
+
+	for config.wait(config.opts.interval) {
+		current, err := queries.ProjectItems(config.client, owner, project.Number, 0)
+		if err != nil {
+			// a failed poll is retried at the next interval rather than ending a long running watch
+			fmt.Fprintf(config.errOut, "could not poll project %d: %s\n", project.Number, err)
+			continue
+		}
+
+		events := diffItems(previous.Items.Nodes, current.Items.Nodes, f, config.opts.fields)
+		err = printEvents(config, events)
+		if err != nil {
+			return err
+		}
+		previous = current
+	}
+	return nil
+}
+
+// diffItems returns the changes between two polls of the items of a project. Changes are only
+// reported for the items matching f, if any, before or after the change, and value changes
+// only for fields, if any.
+func diffItems(previous, current []queries.ProjectItem, f *filter.Filter, fields []string) []format.WatchEvent {
+	events := make([]format.WatchEvent, 0)
+	matches := func(i queries.ProjectItem) bool {
+		return f == nil || f.Match(i)
+	}
+
+	previousByID := make(map[string]queries.ProjectItem, len(previous))
+	for _, i := range previous {
+		previousByID[i.ID()] = i
+	}
+
+	currentIDs := make(map[string]bool, len(current))
+	for _, c := range current {
+		currentIDs[c.ID()] = true
+		p, ok := previousByID[c.ID()]
+		if !ok {
+			if matches(c) {
+				events = append(events, newEvent(c, added))
+			}
+			continue
+		}
+		// an item that stops matching, such as an item moved out of a status, is still reported
+		if !matches(c) && !matches(p) {
+			continue
+		}
+
+		if p.IsArchived != c.IsArchived {
+			if c.IsArchived {
+				events = append(events, newEvent(c, archived))
+			} else {
+				events = append(events, newEvent(c, unarchived))
+			}
+		}
+
+		events = append(events, diffValues(p, c, fields)...)
+	}
+
+	for _, p := range previous {
+		if !currentIDs[p.ID()] && matches(p) {
+			events = append(events, newEvent(p, removed))
+		}
+	}
+
+	return events
+}
+
+// diffValues returns an event for each field value of an item that changed, in field name order.
+func diffValues(previous, current queries.ProjectItem, fields []string) []format.WatchEvent {
+	before := format.FieldValuesText(previous)
+	after := format.FieldValuesText(current)
+
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, values := range []map[string]string{before, after} {
+		for name := range values {
+			if !seen[name] && watched(name, fields) {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	events := make([]format.WatchEvent, 0)
+	for _, name := range names {
+		if before[name] == after[name] {
+			continue
+		}
+		e := newEvent(current, changed)
+		e.Field, e.From, e.To = name, before[name], after[name]
+		events = append(events, e)
+	}
+	return events
+}
+
+// checkFields checks that the --field names are fields of the project, so that a typo does not
+// silently leave out all the value changes.
+func checkFields(projectFields []queries.ProjectField, fields []string) error {
+	for _, name := range fields {
+		found := false
+		for _, f := range projectFields {
+			if strings.EqualFold(f.Name(), name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("field %q not found", name)
+		}
+	}
+	return nil
+}
+
+// watched reports whether changes of the field with the given name are reported.
+func watched(name string, fields []string) bool {
+	if len(fields) == 0 {
+		return true
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func newEvent(item queries.ProjectItem, kind string) format.WatchEvent {
+	return format.WatchEvent{
+		Event: kind,
+		ID:    item.ID(),
+		Item:  itemName(item),
+		Title: item.Title(),
+		URL:   item.URL(),
+	}
+}
+
+// itemName names an item by its reference such as cli/go-gh#1, or its type for draft issues.
+func itemName(item queries.ProjectItem) string {
+	if repo := item.Repo(); repo != "" {
+		return fmt.Sprintf("%s#%d", repo, item.Number())
+	}
+	return item.Type()
+}
+
+func printEvents(config watchConfig, events []format.WatchEvent) error {
+	now := config.now().UTC().Format(time.RFC3339)
+	for _, e := range events {
+		e.Time = now
+		line := eventText(e)
+		if config.opts.format == "json" {
+			b, err := format.JSONWatchEvent(e)
+			if err != nil {
+				return err
+			}
+			line = string(b)
+		}
+		_, err := fmt.Fprintln(config.out, line)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eventText describes an event in a line, such as `cli/go-gh#1 "crash on start" moved Status Todo → Done`.
+func eventText(e format.WatchEvent) string {
+	if e.Event != changed {
+		return fmt.Sprintf("%s %q %s", e.Item, e.Title, e.Event)
+	}
+	return fmt.Sprintf("%s %q moved %s %s → %s", e.Item, e.Title, e.Field, emptyAsDash(e.From), emptyAsDash(e.To))
+}
+
+func emptyAsDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,352 @@
+package watch
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/github/gh-projects/filter"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func issueItem(id string, number int, title string, status string, archived bool) queries.ProjectItem {
+	var field queries.ProjectField
+	field.TypeName = "ProjectV2SingleSelectField"
+	field.SingleSelectField.ID = "status ID"
+	field.SingleSelectField.Name = "Status"
+
+	item := queries.ProjectItem{Id: id, IsArchived: archived}
+	item.Content.TypeName = "Issue"
+	item.Content.Issue.Number = number
+	item.Content.Issue.Title = title
+	item.Content.Issue.Repository.NameWithOwner = "cli/go-gh"
+	value := queries.FieldValueNodes{Type: "ProjectV2ItemFieldSingleSelectValue"}
+	value.ProjectV2ItemFieldSingleSelectValue.Name = status
+	value.ProjectV2ItemFieldSingleSelectValue.Field = field
+	item.FieldValues.Nodes = []queries.FieldValueNodes{value}
+	return item
+}
+
+func eventTexts(events []format.WatchEvent) []string {
+	texts := make([]string, 0, len(events))
+	for _, e := range events {
+		texts = append(texts, eventText(e))
+	}
+	return texts
+}
+
+func TestDiffItems(t *testing.T) {
+	previous := []queries.ProjectItem{
+		issueItem("item 1", 1, "crash on start", "Todo", false),
+		issueItem("item 2", 2, "slow startup", "Todo", false),
+		issueItem("item 4", 4, "typo in docs", "Todo", false),
+	}
+	current := []queries.ProjectItem{
+		issueItem("item 1", 1, "crash on start", "Done", false),
+		issueItem("item 2", 2, "slow startup", "Todo", true),
+		issueItem("item 3", 3, "flaky test", "", false),
+	}
+
+	events := diffItems(previous, current, nil, nil)
+	assert.Equal(t, []string{
+		`cli/go-gh#1 "crash on start" moved Status Todo → Done`,
+		`cli/go-gh#2 "slow startup" archived`,
+		`cli/go-gh#3 "flaky test" added`,
+		`cli/go-gh#4 "typo in docs" removed`,
+	}, eventTexts(events))
+
+	assert.Empty(t, diffItems(current, current, nil, nil))
+}
+
+func TestDiffItems_FilterAndFields(t *testing.T) {
+	previous := []queries.ProjectItem{
+		issueItem("item 1", 1, "crash on start", "Todo", false),
+		issueItem("item 2", 2, "slow startup", "Done", false),
+	}
+	current := []queries.ProjectItem{
+		issueItem("item 1", 1, "crash on start", "Done", false),
+		issueItem("item 2", 2, "slow startup", "Done", true),
+		issueItem("item 3", 3, "flaky test", "Done", false),
+	}
+
+	f, err := filter.Parse("status:Todo")
+	assert.NoError(t, err)
+
+	// the first item no longer matches but its change is reported, the others never matched
+	events := diffItems(previous, current, f, nil)
+	assert.Equal(t, []string{`cli/go-gh#1 "crash on start" moved Status Todo → Done`}, eventTexts(events))
+
+	events = diffItems(previous, current, f, []string{"status"})
+	assert.Equal(t, []string{`cli/go-gh#1 "crash on start" moved Status Todo → Done`}, eventTexts(events))
+
+	events = diffItems(previous, current, nil, []string{"Priority"})
+	assert.Equal(t, []string{`cli/go-gh#2 "slow startup" archived`, `cli/go-gh#3 "flaky test" added`}, eventTexts(events))
+}
+
+func TestRunWatch_JSON(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// the first poll has a draft issue, the second one adds an issue
+	for _, nodes := range [][]map[string]interface{}{
+		{
+			{
+				"id": "item 1",
+				"content": map[string]interface{}{
+					"__typename": "DraftIssue",
+					"title":      "plan release",
+				},
+			},
+		},
+		{
+			{
+				"id": "item 1",
+				"content": map[string]interface{}{
+					"__typename": "DraftIssue",
+					"title":      "plan release",
+				},
+			},
+			{
+				"id": "item 2",
+				"content": map[string]interface{}{
+					"__typename": "Issue",
+					"title":      "crash on start",
+					"number":     1,
+					"url":        "https://github.com/cli/go-gh/issues/1",
+					"repository": map[string]interface{}{"nameWithOwner": "cli/go-gh"},
+				},
+			},
+		},
+	} {
+		gock.New("https://api.github.com").
+			Post("/graphql").
+			MatchType("json").
+			JSON(map[string]interface{}{
+				"query": "query OrgProjectWithItems.*",
+				"variables": map[string]interface{}{
+					"firstItems":  100,
+					"afterItems":  nil,
+					"firstFields": 100,
+					"afterFields": nil,
+					"login":       "github",
+					"number":      1,
+				},
+			}).
+			Reply(200).
+			JSON(map[string]interface{}{
+				"data": map[string]interface{}{
+					"organization": map[string]interface{}{
+						"projectV2": map[string]interface{}{
+							"items": map[string]interface{}{
+								"nodes": nodes,
+							},
+						},
+					},
+				},
+			})
+	}
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	polls := 0
+	config := watchConfig{
+		out: &buf,
+		opts: watchOpts{
+			orgOwner: "github",
+			number:   1,
+			interval: time.Minute,
+			format:   "json",
+		},
+		client: client,
+		wait: func(d time.Duration) bool {
+			assert.Equal(t, time.Minute, d)
+			polls++
+			return polls <= 1
+		},
+		now: func() time.Time {
+			return time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		},
+	}
+
+	err = runWatch(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"time":"2026-10-18T12:00:00Z","event":"added","id":"item 2","item":"cli/go-gh#1","title":"crash on start","url":"https://github.com/cli/go-gh/issues/1"}`+"\n",
+		buf.String())
+	assert.True(t, gock.IsDone())
+}
+
+func TestRunWatch_Interval(t *testing.T) {
+	config := watchConfig{
+		opts: watchOpts{
+			orgOwner: "github",
+			number:   1,
+			interval: time.Second,
+		},
+	}
+
+	err := runWatch(config)
+	assert.EqualError(t, err, "interval must be at least 10s")
+}
+
+func TestRunWatch_UnknownField(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgLogin.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// the first poll lists the fields of the project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  100,
+				"afterItems":  nil,
+				"firstFields": 100,
+				"afterFields": nil,
+				"login":       "github",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{},
+						},
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"name":       "Status",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	config := watchConfig{
+		out: &bytes.Buffer{},
+		opts: watchOpts{
+			orgOwner: "github",
+			number:   1,
+			interval: time.Minute,
+			fields:   []string{"status", "Stauts"},
+		},
+		client: client,
+		wait: func(d time.Duration) bool {
+			t.Error("no poll should follow an unknown field")
+			return false
+		},
+	}
+
+	err = runWatch(config)
+	assert.EqualError(t, err, `field "Stauts" not found`)
+	assert.True(t, gock.IsDone())
+}
@@ -0,0 +1,129 @@
+package workflowdelete
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/queries"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type deleteWorkflowOpts struct {
+	workflowID string
+	format     string
+}
+
+type deleteWorkflowConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   deleteWorkflowOpts
+}
+
+// DeleteProjectV2WorkflowInput is an input type of DeleteProjectV2Workflow.
+type DeleteProjectV2WorkflowInput struct {
+	WorkflowID githubv4.ID `json:"workflowId"`
+}
+
+type deleteProjectV2WorkflowMutation struct {
+	DeleteProjectV2Workflow struct {
+		DeletedWorkflowID string `graphql:"deletedWorkflowId"`
+	} `graphql:"deleteProjectV2Workflow(input:$input)"`
+}
+
+func NewCmdDeleteWorkflow(f *cmdutil.Factory, runF func(config deleteWorkflowConfig) error) *cobra.Command {
+	opts := deleteWorkflowOpts{}
+	deleteWorkflowCmd := &cobra.Command{
+		Short: "Delete a built-in workflow of a project by ID",
+		Use:   "workflow-delete",
+		Long: `
+Delete a built-in workflow of a project by ID. Use workflow-list to find the IDs of the workflows.
+The API does not support enabling or disabling workflows, which can only be done in the browser.`,
+		Example: `
+# delete a workflow by ID
+gh projects workflow-delete --id ID
+
+# add --format=json to output in JSON format
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+			config := deleteWorkflowConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runDeleteWorkflow(config)
+		},
+	}
+
+	deleteWorkflowCmd.Flags().StringVar(&opts.workflowID, "id", "", "ID of the workflow to delete.")
+	deleteWorkflowCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	_ = deleteWorkflowCmd.MarkFlagRequired("id")
+
+	return deleteWorkflowCmd
+}
+
+func runDeleteWorkflow(config deleteWorkflowConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	query, variables := deleteWorkflowArgs(config)
+
+	err := config.client.Mutate("DeleteWorkflow", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config, query.DeleteProjectV2Workflow.DeletedWorkflowID)
+	}
+
+	return printResults(config)
+}
+
+func deleteWorkflowArgs(config deleteWorkflowConfig) (*deleteProjectV2WorkflowMutation, map[string]interface{}) {
+	return &deleteProjectV2WorkflowMutation{}, map[string]interface{}{
+		"input": DeleteProjectV2WorkflowInput{
+			WorkflowID: githubv4.ID(config.opts.workflowID),
+		},
+	}
+}
+
+func printResults(config deleteWorkflowConfig) error {
+	// using table printer here for consistency in case it ends up being needed in the future
+	config.tp.AddField("Deleted workflow")
+	config.tp.EndRow()
+	return config.tp.Render()
+}
+
+func printJSON(config deleteWorkflowConfig, id string) error {
+	b, err := json.Marshal(struct {
+		ID string `json:"id"`
+	}{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
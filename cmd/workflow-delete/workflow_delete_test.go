@@ -0,0 +1,86 @@
+package workflowdelete
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRunDeleteWorkflow(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// delete workflow
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteWorkflow.*","variables":{"input":{"workflowId":"an ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"deleteProjectV2Workflow": map[string]interface{}{
+					"deletedWorkflowId": "an ID",
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteWorkflowConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteWorkflowOpts{
+			workflowID: "an ID",
+		},
+		client: client,
+	}
+
+	err = runDeleteWorkflow(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Deleted workflow\n",
+		buf.String())
+}
+
+func TestRunDeleteWorkflow_JSON(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// delete workflow
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteWorkflow.*","variables":{"input":{"workflowId":"an ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"deleteProjectV2Workflow": map[string]interface{}{
+					"deletedWorkflowId": "an ID",
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	config := deleteWorkflowConfig{
+		tp: tableprinter.New(&buf, false, 0),
+		opts: deleteWorkflowOpts{
+			workflowID: "an ID",
+			format:     "json",
+		},
+		client: client,
+	}
+
+	err = runDeleteWorkflow(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"id":"an ID"}`,
+		buf.String())
+}
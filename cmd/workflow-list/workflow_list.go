@@ -0,0 +1,143 @@
+package workflowlist
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+	"github.com/spf13/cobra"
+)
+
+type listOpts struct {
+	userOwner string
+	orgOwner  string
+	number    int
+	format    string
+}
+
+type listConfig struct {
+	tp     tableprinter.TablePrinter
+	client *api.GraphQLClient
+	opts   listOpts
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.Command {
+	opts := listOpts{}
+	listCmd := &cobra.Command{
+		Short: "List the built-in workflows of a project",
+		Use:   "workflow-list [number]",
+		Example: `
+# list the workflows of the current user's project 1
+gh projects workflow-list 1 --user "@me"
+
+# list the workflows of org github's project 1
+gh projects workflow-list 1 --org github
+
+# add --format=json to output in JSON format
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := queries.NewClient()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				opts.number, err = strconv.Atoi(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			terminal := term.FromEnv()
+			termWidth, _, err := terminal.Size()
+			if err != nil {
+				// set a static width in case of error
+				termWidth = 80
+			}
+			t := tableprinter.New(terminal.Out(), terminal.IsTerminalOutput(), termWidth)
+
+			config := listConfig{
+				tp:     t,
+				client: client,
+				opts:   opts,
+			}
+			return runList(config)
+		},
+	}
+
+	listCmd.Flags().StringVar(&opts.userOwner, "user", "", "Login of the user owner. Use \"@me\" for the current user.")
+	listCmd.Flags().StringVar(&opts.orgOwner, "org", "", "Login of the organization owner.")
+	listCmd.Flags().StringVar(&opts.format, "format", "", "Output format, must be 'json'.")
+
+	// owner can be a user or an org
+	listCmd.MarkFlagsMutuallyExclusive("user", "org")
+
+	return listCmd
+}
+
+func runList(config listConfig) error {
+	if config.opts.format != "" && config.opts.format != "json" {
+		return fmt.Errorf("format must be 'json'")
+	}
+
+	owner, err := queries.NewOwner(config.client, config.opts.userOwner, config.opts.orgOwner)
+	if err != nil {
+		return err
+	}
+
+	project, err := queries.NewProject(config.client, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+
+	workflows, err := queries.ProjectWorkflows(config.client, project.ID)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.format == "json" {
+		return printJSON(config, workflows)
+	}
+
+	return printResults(config, workflows, project.Number)
+}
+
+func printResults(config listConfig, workflows []queries.ProjectWorkflow, number int) error {
+	if len(workflows) == 0 {
+		config.tp.AddField(fmt.Sprintf("Project %d has no workflows", number))
+		config.tp.EndRow()
+		return config.tp.Render()
+	}
+
+	config.tp.AddField("Number")
+	config.tp.AddField("Name")
+	config.tp.AddField("Enabled")
+	config.tp.AddField("ID")
+	config.tp.EndRow()
+
+	for _, w := range workflows {
+		config.tp.AddField(strconv.Itoa(w.Number))
+		config.tp.AddField(w.Name)
+		config.tp.AddField(strconv.FormatBool(w.Enabled))
+		config.tp.AddField(w.ID)
+		config.tp.EndRow()
+	}
+
+	return config.tp.Render()
+}
+
+func printJSON(config listConfig, workflows []queries.ProjectWorkflow) error {
+	b, err := format.JSONProjectWorkflows(workflows)
+	if err != nil {
+		return err
+	}
+	config.tp.AddField(string(b))
+	return config.tp.Render()
+}
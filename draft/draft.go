@@ -0,0 +1,49 @@
+// Package draft reads the title and body of draft issues from files and text editors.
+package draft
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/surveyext"
+)
+
+// ReadBody reads the body of a draft issue from a file, or from stdin if filename is "-".
+func ReadBody(filename string, stdin io.ReadCloser) (string, error) {
+	b, err := cmdutil.ReadFile(filename, stdin)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Edit opens editorCommand on a template holding the title and body of a draft issue,
+// and returns the title and body it was saved with. An empty editorCommand uses
+// $GIT_EDITOR, $VISUAL or $EDITOR.
+func Edit(editorCommand string, title string, body string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (string, string, error) {
+	text, err := surveyext.Edit(editorCommand, "*.md", Template(title, body), stdin, stdout, stderr)
+	if err != nil {
+		return "", "", err
+	}
+
+	title, body = Parse(text)
+	if title == "" {
+		return "", "", errors.New("title is required, aborting")
+	}
+	return title, body, nil
+}
+
+// Template returns the text edited by Edit: the title on the first line, then a blank line and the body.
+func Template(title string, body string) string {
+	return title + "\n\n" + body
+}
+
+// Parse splits text written from Template into a title, the first non-blank line,
+// and a body, the rest of the text without surrounding blank lines.
+func Parse(text string) (string, string) {
+	text = strings.TrimLeft(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	title, body, _ := strings.Cut(text, "\n")
+	return strings.TrimSpace(title), strings.Trim(body, "\n")
+}
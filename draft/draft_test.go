@@ -0,0 +1,33 @@
+package draft
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	title, body := Parse(Template("a title", "a body\n\nwith two paragraphs"))
+	assert.Equal(t, "a title", title)
+	assert.Equal(t, "a body\n\nwith two paragraphs", body)
+
+	title, body = Parse("\r\n  a title  \r\n\r\n## heading\r\n")
+	assert.Equal(t, "a title", title)
+	assert.Equal(t, "## heading", body)
+
+	title, body = Parse("only a title")
+	assert.Equal(t, "only a title", title)
+	assert.Equal(t, "", body)
+
+	title, body = Parse("\n\n")
+	assert.Equal(t, "", title)
+	assert.Equal(t, "", body)
+}
+
+func TestReadBody_Stdin(t *testing.T) {
+	body, err := ReadBody("-", io.NopCloser(strings.NewReader("a body from stdin")))
+	assert.NoError(t, err)
+	assert.Equal(t, "a body from stdin", body)
+}
@@ -0,0 +1,281 @@
+// Package filter implements the subset of the project filter syntax used by saved views, such as
+// `status:Todo,"In progress" -label:bug no:assignee is:open`, and sorting and grouping of project items.
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+)
+
+// Filter is a parsed filter query. The zero value matches every item.
+type Filter struct {
+	terms []term
+}
+
+// term is a single qualifier, such as `-status:Done`, or a free text word matched against the title.
+type term struct {
+	key    string
+	values []string
+	negate bool
+}
+
+// qualifierFields maps qualifiers to the names of the built-in project fields they filter on.
+var qualifierFields = map[string]string{
+	"assignee":  "Assignees",
+	"label":     "Labels",
+	"milestone": "Milestone",
+	"reviewer":  "Reviewers",
+}
+
+var isValues = []string{"issue", "pr", "draft", "open", "closed", "merged", "archived"}
+
+// Parse parses a filter query. Qualifiers are separated by spaces, and values containing spaces must be quoted.
+func Parse(query string) (*Filter, error) {
+	f := &Filter{}
+	for _, token := range tokenize(query) {
+		t := term{}
+		key, value, ok := strings.Cut(token, ":")
+		if !ok {
+			t.values = []string{token}
+			f.terms = append(f.terms, t)
+			continue
+		}
+
+		if strings.HasPrefix(key, "-") {
+			t.negate = true
+			key = key[1:]
+		}
+		t.key = strings.ToLower(key)
+		if t.key == "" || value == "" {
+			return nil, fmt.Errorf("invalid filter %q", token)
+		}
+
+		if strings.Contains(value, "..") || strings.ContainsAny(value[:1], "<>") {
+			t.values = []string{value}
+		} else {
+			t.values = strings.Split(value, ",")
+		}
+
+		if t.key == "is" {
+			for _, v := range t.values {
+				if !contains(isValues, strings.ToLower(v)) {
+					return nil, fmt.Errorf("invalid filter %q, is: must be one of %s", token, strings.Join(isValues, ", "))
+				}
+			}
+		}
+
+		f.terms = append(f.terms, t)
+	}
+	return f, nil
+}
+
+// tokenize splits a query on spaces, keeping quoted text together and removing the quotes.
+func tokenize(query string) []string {
+	tokens := make([]string, 0)
+	var sb strings.Builder
+	quoted := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ' ' && !quoted:
+			if sb.Len() > 0 {
+				tokens = append(tokens, sb.String())
+				sb.Reset()
+			}
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if sb.Len() > 0 {
+		tokens = append(tokens, sb.String())
+	}
+	return tokens
+}
+
+// Items returns the items that match the filter, in their original order.
+func (f *Filter) Items(items []queries.ProjectItem) []queries.ProjectItem {
+	matched := make([]queries.ProjectItem, 0, len(items))
+	for _, i := range items {
+		if f.Match(i) {
+			matched = append(matched, i)
+		}
+	}
+	return matched
+}
+
+// Match reports whether an item matches every term of the filter.
+func (f *Filter) Match(item queries.ProjectItem) bool {
+	for _, t := range f.terms {
+		if t.match(item) == t.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func (t term) match(item queries.ProjectItem) bool {
+	switch t.key {
+	case "":
+		return strings.Contains(strings.ToLower(item.Title()), strings.ToLower(t.values[0]))
+	case "is":
+		for _, v := range t.values {
+			if matchIs(item, strings.ToLower(v)) {
+				return true
+			}
+		}
+		return false
+	case "no":
+		for _, v := range t.values {
+			if len(fieldValues(item, v)) == 0 {
+				return true
+			}
+		}
+		return false
+	case "has":
+		for _, v := range t.values {
+			if len(fieldValues(item, v)) > 0 {
+				return true
+			}
+		}
+		return false
+	case "title":
+		return matchAny(t.values, []string{item.Title()})
+	case "repo":
+		return matchAny(t.values, []string{item.Repo()})
+	case "created":
+		return matchAny(t.values, datePart(item.CreatedAt))
+	case "updated":
+		return matchAny(t.values, datePart(item.UpdatedAt))
+	}
+
+	return matchAny(t.values, fieldValues(item, t.key))
+}
+
+func matchIs(item queries.ProjectItem, value string) bool {
+	switch value {
+	case "issue":
+		return item.Type() == "Issue"
+	case "pr":
+		return item.Type() == "PullRequest"
+	case "draft":
+		return item.Type() == "DraftIssue"
+	case "archived":
+		return item.IsArchived
+	}
+	// drafts have no state, and are considered open
+	state := item.State()
+	if state == "" {
+		state = "OPEN"
+	}
+	return strings.EqualFold(state, value)
+}
+
+// fieldValues returns the text entries of the field matching a qualifier, or nil if the item has no value.
+func fieldValues(item queries.ProjectItem, qualifier string) []string {
+	if name, ok := qualifierFields[strings.ToLower(qualifier)]; ok {
+		qualifier = name
+	}
+	v := FieldValue(item, qualifier)
+	if v == nil {
+		return nil
+	}
+
+	values := make([]string, 0)
+	for _, text := range format.FieldValueTexts(*v) {
+		if text != "" {
+			values = append(values, text)
+		}
+	}
+	return values
+}
+
+// FieldValue returns the value of the named field of an item, or nil if the item has no value.
+// Names are compared case-insensitively and hyphens match spaces, so `due-date` finds "Due date".
+func FieldValue(item queries.ProjectItem, name string) *queries.FieldValueNodes {
+	name = strings.ReplaceAll(name, "-", " ")
+	for i, v := range item.FieldValues.Nodes {
+		if strings.EqualFold(strings.ReplaceAll(v.Field().Name(), "-", " "), name) {
+			return &item.FieldValues.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// matchAny reports whether any entry matches any of the filter values.
+func matchAny(filterValues []string, entries []string) bool {
+	for _, fv := range filterValues {
+		for _, e := range entries {
+			if matchValue(fv, e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchValue compares an entry against a filter value, which can be a comparison such as `>=3`,
+// a range such as `1..5` or `2023-01-01..2023-01-31`, or a plain value matched case-insensitively.
+func matchValue(filterValue, entry string) bool {
+	if low, high, ok := strings.Cut(filterValue, ".."); ok {
+		return (low == "*" || compare(entry, low) >= 0) && (high == "*" || compare(entry, high) <= 0)
+	}
+
+	for _, op := range []string{">=", "<=", ">", "<"} {
+		if strings.HasPrefix(filterValue, op) {
+			c := compare(entry, strings.TrimPrefix(filterValue, op))
+			switch op {
+			case ">=":
+				return c >= 0
+			case "<=":
+				return c <= 0
+			case ">":
+				return c > 0
+			default:
+				return c < 0
+			}
+		}
+	}
+
+	return strings.EqualFold(filterValue, entry)
+}
+
+// compare compares two values numerically if both are numbers, and as strings otherwise,
+// which orders dates of the form YYYY-MM-DD correctly.
+func compare(a, b string) int {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// datePart returns the YYYY-MM-DD date of a timestamp such as 2023-06-12T09:30:00Z as the entries
+// to match, which are empty if the timestamp is.
+func datePart(timestamp string) []string {
+	if timestamp == "" {
+		return nil
+	}
+	date, _, _ := strings.Cut(timestamp, "T")
+	return []string{date}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
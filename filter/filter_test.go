@@ -0,0 +1,112 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/github/gh-projects/queries"
+	"github.com/stretchr/testify/assert"
+)
+
+func singleSelectValue(field, option string, options ...string) queries.FieldValueNodes {
+	v := queries.FieldValueNodes{Type: "ProjectV2ItemFieldSingleSelectValue"}
+	v.ProjectV2ItemFieldSingleSelectValue.Name = option
+	v.ProjectV2ItemFieldSingleSelectValue.OptionId = option + " ID"
+	v.ProjectV2ItemFieldSingleSelectValue.Field.TypeName = "ProjectV2SingleSelectField"
+	v.ProjectV2ItemFieldSingleSelectValue.Field.SingleSelectField.Name = field
+	for _, o := range options {
+		v.ProjectV2ItemFieldSingleSelectValue.Field.SingleSelectField.Options = append(
+			v.ProjectV2ItemFieldSingleSelectValue.Field.SingleSelectField.Options,
+			queries.SingleSelectFieldOptions{ID: o + " ID", Name: o})
+	}
+	return v
+}
+
+func numberValue(field string, n float32) queries.FieldValueNodes {
+	v := queries.FieldValueNodes{Type: "ProjectV2ItemFieldNumberValue"}
+	v.ProjectV2ItemFieldNumberValue.Number = n
+	v.ProjectV2ItemFieldNumberValue.Field.TypeName = "ProjectV2Field"
+	v.ProjectV2ItemFieldNumberValue.Field.Field.Name = field
+	return v
+}
+
+func labelsValue(labels ...string) queries.FieldValueNodes {
+	v := queries.FieldValueNodes{Type: "ProjectV2ItemFieldLabelValue"}
+	for _, l := range labels {
+		v.ProjectV2ItemFieldLabelValue.Labels.Nodes = append(v.ProjectV2ItemFieldLabelValue.Labels.Nodes, struct{ Name string }{Name: l})
+	}
+	v.ProjectV2ItemFieldLabelValue.Field.TypeName = "ProjectV2Field"
+	v.ProjectV2ItemFieldLabelValue.Field.Field.Name = "Labels"
+	return v
+}
+
+func issue(title, state string, values ...queries.FieldValueNodes) queries.ProjectItem {
+	i := queries.ProjectItem{Id: title}
+	i.Content.TypeName = "Issue"
+	i.Content.Issue.Title = title
+	i.Content.Issue.State = state
+	i.Content.Issue.Repository.NameWithOwner = "cli/go-gh"
+	i.FieldValues.Nodes = values
+	return i
+}
+
+func draft(title string, values ...queries.FieldValueNodes) queries.ProjectItem {
+	i := queries.ProjectItem{Id: title}
+	i.Content.TypeName = "DraftIssue"
+	i.Content.DraftIssue.Title = title
+	i.FieldValues.Nodes = values
+	return i
+}
+
+func ids(items []queries.ProjectItem) []string {
+	result := make([]string, 0, len(items))
+	for _, i := range items {
+		result = append(result, i.ID())
+	}
+	return result
+}
+
+func TestFilter(t *testing.T) {
+	items := []queries.ProjectItem{
+		issue("fix login", "OPEN", singleSelectValue("Status", "Todo"), numberValue("Story points", 3), labelsValue("bug", "p1")),
+		issue("add docs", "CLOSED", singleSelectValue("Status", "Done"), numberValue("Story points", 1)),
+		draft("plan release", singleSelectValue("Status", "In progress"), labelsValue("p1")),
+	}
+	items[0].UpdatedAt = "2023-06-12T09:30:00Z"
+	items[1].UpdatedAt = "2023-05-02T18:00:00Z"
+	items[1].IsArchived = true
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "", want: []string{"fix login", "add docs", "plan release"}},
+		{query: "status:todo", want: []string{"fix login"}},
+		{query: `status:Todo,"In progress"`, want: []string{"fix login", "plan release"}},
+		{query: "-status:Done", want: []string{"fix login", "plan release"}},
+		{query: "label:p1 is:issue", want: []string{"fix login"}},
+		{query: "is:open", want: []string{"fix login", "plan release"}},
+		{query: "no:label", want: []string{"add docs"}},
+		{query: "has:story-points", want: []string{"fix login", "add docs"}},
+		{query: "story-points:>1", want: []string{"fix login"}},
+		{query: "story-points:1..2", want: []string{"add docs"}},
+		{query: "repo:CLI/go-gh docs", want: []string{"add docs"}},
+		{query: "updated:<2023-06-01", want: []string{"add docs"}},
+		{query: "is:archived", want: []string{"add docs"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			f, err := Parse(tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ids(f.Items(items)))
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse("is:stale")
+	assert.EqualError(t, err, `invalid filter "is:stale", is: must be one of issue, pr, draft, open, closed, merged, archived`)
+
+	_, err = Parse("status:")
+	assert.EqualError(t, err, `invalid filter "status:"`)
+}
@@ -0,0 +1,62 @@
+package filter
+
+import (
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+)
+
+// Group is a set of items sharing a value of a project field. Value is empty for the items without a value.
+type Group struct {
+	Value string
+	Items []queries.ProjectItem
+}
+
+// GroupItems groups items by the values of a project field. Items with several values, such as labels
+// or assignees, are in the group of each value. Groups follow the sort order of the field, such as the
+// order of single select options, and the group of items without a value is last.
+func GroupItems(items []queries.ProjectItem, field string) []Group {
+	sorted := make([]queries.ProjectItem, len(items))
+	copy(sorted, items)
+	Sort(sorted, []SortKey{{Field: field}})
+
+	groups := make([]Group, 0)
+	index := make(map[string]int)
+	for _, item := range sorted {
+		values := []string{""}
+		if v := FieldValue(item, field); v != nil {
+			if texts := format.FieldValueTexts(*v); len(texts) > 0 {
+				values = texts
+			}
+		}
+
+		for _, value := range values {
+			i, ok := index[value]
+			if !ok {
+				i = len(groups)
+				index[value] = i
+				groups = append(groups, Group{Value: value})
+			}
+			groups[i].Items = append(groups[i].Items, item)
+		}
+	}
+
+	// items without a value sort last, but a multi-valued field may have created the group earlier
+	if i, ok := index[""]; ok && i != len(groups)-1 {
+		none := groups[i]
+		groups = append(groups[:i], groups[i+1:]...)
+		groups = append(groups, none)
+	}
+
+	return groups
+}
+
+// Sum returns the total of the values of a number field over items.
+func Sum(items []queries.ProjectItem, field string) float32 {
+	var sum float32
+	for _, item := range items {
+		if v := FieldValue(item, field); v != nil && v.Type == "ProjectV2ItemFieldNumberValue" {
+			sum += v.ProjectV2ItemFieldNumberValue.Number
+		}
+	}
+	return sum
+}
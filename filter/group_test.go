@@ -0,0 +1,50 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/github/gh-projects/queries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupItems(t *testing.T) {
+	options := []string{"Todo", "In progress", "Done"}
+	items := []queries.ProjectItem{
+		draft("a", singleSelectValue("Status", "Done", options...), numberValue("Points", 1)),
+		draft("b"),
+		draft("c", singleSelectValue("Status", "Todo", options...), numberValue("Points", 2)),
+		draft("d", singleSelectValue("Status", "Done", options...), numberValue("Points", 5)),
+	}
+
+	groups := GroupItems(items, "Status")
+	assert.Len(t, groups, 3)
+	assert.Equal(t, "Todo", groups[0].Value)
+	assert.Equal(t, []string{"c"}, ids(groups[0].Items))
+	assert.Equal(t, "Done", groups[1].Value)
+	assert.Equal(t, []string{"a", "d"}, ids(groups[1].Items))
+	assert.Equal(t, "", groups[2].Value)
+	assert.Equal(t, []string{"b"}, ids(groups[2].Items))
+
+	assert.Equal(t, float32(6), Sum(groups[1].Items, "Points"))
+	assert.Equal(t, float32(0), Sum(groups[2].Items, "Points"))
+
+	// the items are left in their original order
+	assert.Equal(t, []string{"a", "b", "c", "d"}, ids(items))
+}
+
+func TestGroupItems_MultipleValues(t *testing.T) {
+	items := []queries.ProjectItem{
+		draft("a", labelsValue("bug", "docs")),
+		draft("b", labelsValue()),
+		draft("c", labelsValue("docs")),
+	}
+
+	groups := GroupItems(items, "labels")
+	assert.Len(t, groups, 3)
+	assert.Equal(t, "bug", groups[0].Value)
+	assert.Equal(t, []string{"a"}, ids(groups[0].Items))
+	assert.Equal(t, "docs", groups[1].Value)
+	assert.Equal(t, []string{"a", "c"}, ids(groups[1].Items))
+	assert.Equal(t, "", groups[2].Value)
+	assert.Equal(t, []string{"b"}, ids(groups[2].Items))
+}
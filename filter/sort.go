@@ -0,0 +1,161 @@
+package filter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/github/gh-projects/format"
+	"github.com/github/gh-projects/queries"
+)
+
+// SortKey sorts items by the values of a project field, or by one of the
+// built-in attributes title, number, repo and type if Builtin is set.
+type SortKey struct {
+	Field   string
+	Desc    bool
+	Builtin bool
+}
+
+// builtinSortFields are the item attributes that can be sorted by without a project field of the same name.
+var builtinSortFields = map[string]bool{
+	"title":  true,
+	"number": true,
+	"repo":   true,
+	"type":   true,
+}
+
+// ParseSortKey parses a sort key of the form FIELD[:asc|desc], which sorts ascending by default.
+// FIELD is the name of one of fields, or else one of the built-in attributes title, number, repo and type.
+func ParseSortKey(value string, fields []queries.ProjectField) (SortKey, error) {
+	key := SortKey{Field: value}
+	if i := strings.LastIndex(value, ":"); i >= 0 {
+		switch strings.ToLower(value[i+1:]) {
+		case "asc":
+		case "desc":
+			key.Desc = true
+		default:
+			return SortKey{}, fmt.Errorf("invalid sort %q, direction must be asc or desc", value)
+		}
+		key.Field = value[:i]
+	}
+
+	for _, f := range fields {
+		if strings.EqualFold(f.Name(), key.Field) {
+			key.Field = f.Name()
+			return key, nil
+		}
+	}
+	if builtinSortFields[strings.ToLower(key.Field)] {
+		key.Field = strings.ToLower(key.Field)
+		key.Builtin = true
+		return key, nil
+	}
+	return SortKey{}, fmt.Errorf("invalid sort %q, no field named %q", value, key.Field)
+}
+
+// SortKeysFromView returns the sort keys of a saved view.
+func SortKeysFromView(view queries.ProjectView) []SortKey {
+	keys := make([]SortKey, 0, len(view.SortByFields.Nodes))
+	for _, s := range view.SortByFields.Nodes {
+		keys = append(keys, SortKey{
+			Field: s.Field.Name(),
+			Desc:  s.Direction == "DESC",
+		})
+	}
+	return keys
+}
+
+// Sort sorts items in place by the keys, in order of precedence. Items without a value for a key
+// sort after items with a value regardless of direction, and ties keep their original order.
+func Sort(items []queries.ProjectItem, keys []SortKey) {
+	sort.SliceStable(items, func(i, j int) bool {
+		for _, k := range keys {
+			c, aOK, bOK := compareItems(items[i], items[j], k)
+			if !aOK || !bOK {
+				if !aOK && !bOK {
+					continue
+				}
+				return aOK
+			}
+			if c == 0 {
+				continue
+			}
+			if k.Desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+}
+
+// compareItems compares two items by a sort key. aOK and bOK report whether each item has a value for the key.
+func compareItems(a, b queries.ProjectItem, k SortKey) (c int, aOK bool, bOK bool) {
+	if k.Builtin {
+		switch k.Field {
+		case "number":
+			return compareInts(a.Number(), b.Number()), a.Number() != 0, b.Number() != 0
+		case "repo":
+			return strings.Compare(strings.ToLower(a.Repo()), strings.ToLower(b.Repo())), a.Repo() != "", b.Repo() != ""
+		case "type":
+			return strings.Compare(a.Type(), b.Type()), true, true
+		}
+		return strings.Compare(strings.ToLower(a.Title()), strings.ToLower(b.Title())), true, true
+	}
+
+	av := FieldValue(a, k.Field)
+	bv := FieldValue(b, k.Field)
+	if av == nil || bv == nil {
+		return 0, av != nil, bv != nil
+	}
+	return compareFieldValues(*av, *bv), true, true
+}
+
+// compareFieldValues compares two values of the same field according to its type:
+// single select values by option order, iterations by start date, numbers numerically
+// and everything else as case-insensitive text.
+func compareFieldValues(a, b queries.FieldValueNodes) int {
+	switch a.Type {
+	case "ProjectV2ItemFieldSingleSelectValue":
+		return compareInts(optionIndex(a), optionIndex(b))
+	case "ProjectV2ItemFieldIterationValue":
+		return strings.Compare(a.ProjectV2ItemFieldIterationValue.StartDate, b.ProjectV2ItemFieldIterationValue.StartDate)
+	case "ProjectV2ItemFieldNumberValue":
+		return compareFloats(a.ProjectV2ItemFieldNumberValue.Number, b.ProjectV2ItemFieldNumberValue.Number)
+	case "ProjectV2ItemFieldDateValue":
+		return strings.Compare(a.ProjectV2ItemFieldDateValue.Date, b.ProjectV2ItemFieldDateValue.Date)
+	}
+	return strings.Compare(strings.ToLower(format.FieldValueText(a)), strings.ToLower(format.FieldValueText(b)))
+}
+
+// optionIndex is the position of a single select value in the options of its field.
+func optionIndex(v queries.FieldValueNodes) int {
+	value := v.ProjectV2ItemFieldSingleSelectValue
+	for i, o := range value.Field.Options() {
+		if o.ID == value.OptionId || (value.OptionId == "" && o.Name == value.Name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloats(a, b float32) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
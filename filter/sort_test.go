@@ -0,0 +1,80 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/github/gh-projects/queries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	options := []string{"Todo", "In progress", "Done"}
+	items := []queries.ProjectItem{
+		draft("a", singleSelectValue("Status", "Done", options...), numberValue("Points", 1)),
+		draft("b"),
+		draft("c", singleSelectValue("Status", "Todo", options...), numberValue("Points", 2)),
+		draft("d", singleSelectValue("Status", "Done", options...), numberValue("Points", 5)),
+	}
+
+	Sort(items, []SortKey{{Field: "status"}, {Field: "Points", Desc: true}})
+	assert.Equal(t, []string{"c", "d", "a", "b"}, ids(items))
+
+	Sort(items, []SortKey{{Field: "Status", Desc: true}})
+	assert.Equal(t, []string{"d", "a", "c", "b"}, ids(items))
+}
+
+func TestSortKeysFromView(t *testing.T) {
+	view := queries.ProjectView{}
+	sortBy := queries.ProjectViewSortBy{Direction: "DESC"}
+	sortBy.Field.TypeName = "ProjectV2Field"
+	sortBy.Field.Field.Name = "Points"
+	view.SortByFields.Nodes = []queries.ProjectViewSortBy{sortBy}
+
+	assert.Equal(t, []SortKey{{Field: "Points", Desc: true}}, SortKeysFromView(view))
+}
+
+func TestSort_Builtin(t *testing.T) {
+	a := issue("a", "OPEN")
+	a.Content.Issue.Number = 2
+	b := issue("B", "OPEN")
+	b.Content.Issue.Number = 1
+	items := []queries.ProjectItem{draft("c"), a, b}
+
+	Sort(items, []SortKey{{Field: "number", Builtin: true}})
+	assert.Equal(t, []string{"B", "a", "c"}, ids(items))
+
+	Sort(items, []SortKey{{Field: "title", Desc: true, Builtin: true}})
+	assert.Equal(t, []string{"c", "B", "a"}, ids(items))
+}
+
+func TestParseSortKey(t *testing.T) {
+	var points queries.ProjectField
+	points.TypeName = "ProjectV2Field"
+	points.Field.Name = "Points"
+	fields := []queries.ProjectField{points}
+
+	tests := []struct {
+		value string
+		want  SortKey
+		err   string
+	}{
+		{value: "points", want: SortKey{Field: "Points"}},
+		{value: "Points:desc", want: SortKey{Field: "Points", Desc: true}},
+		{value: "Title:ASC", want: SortKey{Field: "title", Builtin: true}},
+		{value: "repo:desc", want: SortKey{Field: "repo", Desc: true, Builtin: true}},
+		{value: "points:up", err: `invalid sort "points:up", direction must be asc or desc`},
+		{value: "Size", err: `invalid sort "Size", no field named "Size"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			key, err := ParseSortKey(tt.value, fields)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, key)
+		})
+	}
+}
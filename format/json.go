@@ -9,7 +9,25 @@ import (
 
 // JSONProject serializes a Project to JSON.
 func JSONProject(project queries.Project) ([]byte, error) {
-	return json.Marshal(projectJSON{
+	return json.Marshal(serializeProject(project))
+}
+
+// JSONProjectWithLinks serializes a Project to JSON along with the linked repositories and teams.
+// JSON fields are the fields of JSONProject, `repositories` and `teams`.
+func JSONProjectWithLinks(project queries.Project, repos []string, teams []string) ([]byte, error) {
+	return json.Marshal(struct {
+		projectJSON
+		Repositories []string `json:"repositories"`
+		Teams        []string `json:"teams"`
+	}{
+		projectJSON:  serializeProject(project),
+		Repositories: repos,
+		Teams:        teams,
+	})
+}
+
+func serializeProject(project queries.Project) projectJSON {
+	return projectJSON{
 		Number:           project.Number,
 		URL:              project.URL,
 		ShortDescription: project.ShortDescription,
@@ -35,7 +53,7 @@ func JSONProject(project queries.Project) ([]byte, error) {
 			Type:  project.OwnerType(),
 			Login: project.OwnerLogin(),
 		},
-	})
+	}
 }
 
 // JSONProjects serializes a slice of Projects to JSON.
@@ -163,20 +181,111 @@ type singleSelectOptionJSON struct {
 // JSONProjectItem serializes a ProjectItem to JSON.
 func JSONProjectItem(item queries.ProjectItem) ([]byte, error) {
 	return json.Marshal(projectItemJSON{
-		ID:    item.ID(),
-		Title: item.Title(),
-		Body:  item.Body(),
-		Type:  item.Type(),
-		URL:   item.URL(),
+		ID:         item.ID(),
+		Title:      item.Title(),
+		Body:       item.Body(),
+		Type:       item.Type(),
+		URL:        item.URL(),
+		IsArchived: item.IsArchived,
+	})
+}
+
+// JSONProjectItemWithValues serializes a ProjectItem to JSON along with the field values set on it,
+// keyed by field name.
+func JSONProjectItemWithValues(item queries.ProjectItem, values []queries.FieldValueInput) ([]byte, error) {
+	fields := make(map[string]string, len(values))
+	for _, v := range values {
+		fields[v.Field.Name()] = v.Text
+	}
+	return json.Marshal(projectItemJSON{
+		ID:         item.ID(),
+		Title:      item.Title(),
+		Body:       item.Body(),
+		Type:       item.Type(),
+		URL:        item.URL(),
+		IsArchived: item.IsArchived,
+		Fields:     fields,
+	})
+}
+
+// ItemResult is the outcome of adding or changing an item referred to by Reference, such as cli/go-gh#1.
+// Skipped items were left alone, such as items already in a project.
+type ItemResult struct {
+	Reference string
+	Item      *queries.ProjectItem
+	Skipped   bool
+	Err       error
+}
+
+// JSONItemResults serializes the outcome of a command acting on several items, with each item
+// and the field values set on it, or the error for the items that failed.
+func JSONItemResults(results []ItemResult, values []queries.FieldValueInput) ([]byte, error) {
+	entries := make([]itemResultJSON, 0, len(results))
+	for _, r := range results {
+		entry := itemResultJSON{Reference: r.Reference}
+		if r.Err != nil {
+			entry.Error = r.Err.Error()
+		} else if r.Skipped {
+			entry.Skipped = true
+		} else {
+			b, err := JSONProjectItemWithValues(*r.Item, values)
+			if err != nil {
+				return nil, err
+			}
+			entry.Item = b
+		}
+		entries = append(entries, entry)
+	}
+	return json.Marshal(struct {
+		Results []itemResultJSON `json:"results"`
+	}{
+		Results: entries,
 	})
 }
 
+// JSONBulkItems serializes the items a bulk command acted on, such as the items archived with a query,
+// or the items it would act on in a dry run. JSON fields are `action`, `dryRun`, `items` and `totalCount`.
+func JSONBulkItems(action string, dryRun bool, items []queries.ProjectItem) ([]byte, error) {
+	result := make([]projectItemJSON, 0, len(items))
+	for _, i := range items {
+		result = append(result, projectItemJSON{
+			ID:         i.ID(),
+			Title:      i.Title(),
+			Body:       i.Body(),
+			Type:       i.Type(),
+			URL:        i.URL(),
+			IsArchived: i.IsArchived,
+		})
+	}
+
+	return json.Marshal(struct {
+		Action     string            `json:"action"`
+		DryRun     bool              `json:"dryRun"`
+		Items      []projectItemJSON `json:"items"`
+		TotalCount int               `json:"totalCount"`
+	}{
+		Action:     action,
+		DryRun:     dryRun,
+		Items:      result,
+		TotalCount: len(items),
+	})
+}
+
+type itemResultJSON struct {
+	Reference string          `json:"reference"`
+	Item      json.RawMessage `json:"item,omitempty"`
+	Skipped   bool            `json:"skipped,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
 type projectItemJSON struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
-	Body  string `json:"body"`
-	Type  string `json:"type"`
-	URL   string `json:"url,omitempty"`
+	ID         string            `json:"id"`
+	Title      string            `json:"title"`
+	Body       string            `json:"body"`
+	Type       string            `json:"type"`
+	URL        string            `json:"url,omitempty"`
+	IsArchived bool              `json:"isArchived"`
+	Fields     map[string]string `json:"fields,omitempty"`
 }
 
 // JSONProjectDraftIssue serializes a DraftIssue to JSON.
@@ -327,6 +436,7 @@ func serializeProjectWithItems(project *queries.Project) []map[string]any {
 	for _, i := range project.Items.Nodes {
 		o := make(map[string]any)
 		o["id"] = i.Id
+		o["isArchived"] = i.IsArchived
 		o["content"] = projectItemContent(i)
 		for _, v := range i.FieldValues.Nodes {
 			id := v.ID()
@@ -352,6 +462,45 @@ func JSONProjectDetailedItems(project *queries.Project) ([]byte, error) {
 	})
 }
 
+// ItemGroup is a group of project items sharing the value of a field.
+// Sum is the total of the summed field, if any.
+type ItemGroup struct {
+	Value string
+	Items []queries.ProjectItem
+	Sum   *float32
+}
+
+// JSONProjectItemGroups returns a detailed JSON representation of groups of project items.
+// JSON fields are `groups` and `totalCount`, and each group has `value`, `count`, `sum` and `items`.
+func JSONProjectItemGroups(project *queries.Project, groups []ItemGroup) ([]byte, error) {
+	result := make([]itemGroupJSON, 0, len(groups))
+	for _, g := range groups {
+		p := *project
+		p.Items.Nodes = g.Items
+		result = append(result, itemGroupJSON{
+			Value: g.Value,
+			Count: len(g.Items),
+			Sum:   g.Sum,
+			Items: serializeProjectWithItems(&p),
+		})
+	}
+
+	return json.Marshal(struct {
+		Groups     []itemGroupJSON `json:"groups"`
+		TotalCount int             `json:"totalCount"`
+	}{
+		Groups:     result,
+		TotalCount: project.Items.TotalCount,
+	})
+}
+
+type itemGroupJSON struct {
+	Value string           `json:"value"`
+	Count int              `json:"count"`
+	Sum   *float32         `json:"sum,omitempty"`
+	Items []map[string]any `json:"items"`
+}
+
 // CamelCase converts a string to camelCase, which is useful for turning Go field names to JSON keys.
 func CamelCase(s string) string {
 	if len(s) == 0 {
@@ -363,3 +512,262 @@ func CamelCase(s string) string {
 	}
 	return strings.ToLower(s[0:1]) + s[1:]
 }
+
+// JSONProjectCollaborators serializes a slice of ProjectCollaborators to JSON.
+// JSON fields are `totalCount` and `collaborators`.
+func JSONProjectCollaborators(collaborators []queries.ProjectCollaborator) ([]byte, error) {
+	result := make([]projectCollaboratorJSON, 0, len(collaborators))
+	for _, c := range collaborators {
+		result = append(result, projectCollaboratorJSON{
+			Type: c.Type(),
+			Name: c.Name(),
+			Role: c.Role,
+		})
+	}
+
+	return json.Marshal(struct {
+		Collaborators []projectCollaboratorJSON `json:"collaborators"`
+		TotalCount    int                       `json:"totalCount"`
+	}{
+		Collaborators: result,
+		TotalCount:    len(collaborators),
+	})
+}
+
+type projectCollaboratorJSON struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+// JSONStatusUpdate serializes a StatusUpdate to JSON.
+func JSONStatusUpdate(update queries.StatusUpdate) ([]byte, error) {
+	return json.Marshal(statusUpdateToJSON(update))
+}
+
+// JSONStatusUpdates serializes a slice of StatusUpdates to JSON.
+// JSON fields are `totalCount` and `statusUpdates`.
+func JSONStatusUpdates(updates []queries.StatusUpdate, totalCount int) ([]byte, error) {
+	result := make([]statusUpdateJSON, 0, len(updates))
+	for _, u := range updates {
+		result = append(result, statusUpdateToJSON(u))
+	}
+
+	return json.Marshal(struct {
+		StatusUpdates []statusUpdateJSON `json:"statusUpdates"`
+		TotalCount    int                `json:"totalCount"`
+	}{
+		StatusUpdates: result,
+		TotalCount:    totalCount,
+	})
+}
+
+func statusUpdateToJSON(u queries.StatusUpdate) statusUpdateJSON {
+	return statusUpdateJSON{
+		ID:         u.ID,
+		Status:     u.Status,
+		Body:       u.Body,
+		StartDate:  u.StartDate,
+		TargetDate: u.TargetDate,
+		CreatedAt:  u.CreatedAt,
+		Creator:    u.Creator.Login,
+	}
+}
+
+type statusUpdateJSON struct {
+	ID         string `json:"id"`
+	Status     string `json:"status"`
+	Body       string `json:"body"`
+	StartDate  string `json:"startDate,omitempty"`
+	TargetDate string `json:"targetDate,omitempty"`
+	CreatedAt  string `json:"createdAt"`
+	Creator    string `json:"creator"`
+}
+
+// JSONProjectView serializes a ProjectView to JSON.
+func JSONProjectView(view queries.ProjectView) ([]byte, error) {
+	return json.Marshal(projectViewToJSON(view))
+}
+
+// JSONProjectViews serializes a slice of ProjectViews to JSON.
+// JSON fields are `totalCount` and `views`.
+func JSONProjectViews(views []queries.ProjectView) ([]byte, error) {
+	result := make([]projectViewJSON, 0, len(views))
+	for _, v := range views {
+		result = append(result, projectViewToJSON(v))
+	}
+
+	return json.Marshal(struct {
+		Views      []projectViewJSON `json:"views"`
+		TotalCount int               `json:"totalCount"`
+	}{
+		Views:      result,
+		TotalCount: len(views),
+	})
+}
+
+func projectViewToJSON(v queries.ProjectView) projectViewJSON {
+	sortBy := make([]projectViewSortJSON, 0, len(v.SortByFields.Nodes))
+	for _, s := range v.SortByFields.Nodes {
+		sortBy = append(sortBy, projectViewSortJSON{
+			Field:     s.Field.Name(),
+			Direction: s.Direction,
+		})
+	}
+
+	return projectViewJSON{
+		ID:              v.ID,
+		Name:            v.Name,
+		Number:          v.Number,
+		Layout:          v.Layout,
+		Filter:          v.Filter,
+		Fields:          fieldNames(v.Fields.Nodes),
+		GroupBy:         fieldNames(v.GroupByFields.Nodes),
+		VerticalGroupBy: fieldNames(v.VerticalGroupByFields.Nodes),
+		SortBy:          sortBy,
+	}
+}
+
+func fieldNames(fields []queries.ProjectField) []string {
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, f.Name())
+	}
+	return names
+}
+
+type projectViewJSON struct {
+	ID              string                `json:"id"`
+	Name            string                `json:"name"`
+	Number          int                   `json:"number"`
+	Layout          string                `json:"layout"`
+	Filter          string                `json:"filter"`
+	Fields          []string              `json:"fields"`
+	GroupBy         []string              `json:"groupBy"`
+	VerticalGroupBy []string              `json:"verticalGroupBy"`
+	SortBy          []projectViewSortJSON `json:"sortBy"`
+}
+
+type projectViewSortJSON struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"`
+}
+
+// JSONProjectWorkflows serializes a slice of ProjectWorkflows to JSON.
+// JSON fields are `totalCount` and `workflows`.
+func JSONProjectWorkflows(workflows []queries.ProjectWorkflow) ([]byte, error) {
+	result := make([]projectWorkflowJSON, 0, len(workflows))
+	for _, w := range workflows {
+		result = append(result, projectWorkflowJSON{
+			ID:      w.ID,
+			Name:    w.Name,
+			Number:  w.Number,
+			Enabled: w.Enabled,
+		})
+	}
+
+	return json.Marshal(struct {
+		Workflows  []projectWorkflowJSON `json:"workflows"`
+		TotalCount int                   `json:"totalCount"`
+	}{
+		Workflows:  result,
+		TotalCount: len(workflows),
+	})
+}
+
+type projectWorkflowJSON struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Number  int    `json:"number"`
+	Enabled bool   `json:"enabled"`
+}
+
+// SyncReport holds the changes made, or that would be made on a dry run, to sync a project with a search.
+type SyncReport struct {
+	DryRun    bool
+	Changes   []SyncChange
+	Unchanged int
+}
+
+// SyncChange is an item added, unarchived or archived by a sync, such as cli/go-gh#1.
+// ContentID is the ID of the issue or pull request to add.
+type SyncChange struct {
+	Item      string
+	Title     string
+	URL       string
+	Change    string
+	ContentID string
+}
+
+// JSONSyncReport serializes the changes of a sync along with the number of items left unchanged.
+// JSON fields are `dryRun`, `changes` and `unchanged`, and each change has `item`, `title`, `url` and `change`.
+func JSONSyncReport(report SyncReport) ([]byte, error) {
+	changes := make([]syncChangeJSON, 0, len(report.Changes))
+	for _, c := range report.Changes {
+		changes = append(changes, syncChangeJSON{
+			Item:   c.Item,
+			Title:  c.Title,
+			URL:    c.URL,
+			Change: c.Change,
+		})
+	}
+
+	return json.Marshal(struct {
+		DryRun    bool             `json:"dryRun"`
+		Changes   []syncChangeJSON `json:"changes"`
+		Unchanged int              `json:"unchanged"`
+	}{
+		DryRun:    report.DryRun,
+		Changes:   changes,
+		Unchanged: report.Unchanged,
+	})
+}
+
+type syncChangeJSON struct {
+	Item   string `json:"item"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+	Change string `json:"change"`
+}
+
+// WatchEvent is a change to an item of a project between two polls, such as an added item
+// or a changed field value. Field, From and To are only set for changed field values.
+type WatchEvent struct {
+	Time  string
+	Event string
+	ID    string
+	Item  string
+	Title string
+	URL   string
+	Field string
+	From  string
+	To    string
+}
+
+// JSONWatchEvent serializes a WatchEvent to JSON. JSON fields are `time`, `event`, `id`, `item`,
+// `title`, `url`, `field`, `from` and `to`, and the last four are left out when empty.
+func JSONWatchEvent(e WatchEvent) ([]byte, error) {
+	return json.Marshal(watchEventJSON{
+		Time:  e.Time,
+		Event: e.Event,
+		ID:    e.ID,
+		Item:  e.Item,
+		Title: e.Title,
+		URL:   e.URL,
+		Field: e.Field,
+		From:  e.From,
+		To:    e.To,
+	})
+}
+
+type watchEventJSON struct {
+	Time  string `json:"time"`
+	Event string `json:"event"`
+	ID    string `json:"id"`
+	Item  string `json:"item"`
+	Title string `json:"title"`
+	URL   string `json:"url,omitempty"`
+	Field string `json:"field,omitempty"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
@@ -178,7 +178,7 @@ func TestJSONProjectItem_DraftIssue(t *testing.T) {
 	b, err := JSONProjectItem(item)
 	assert.NoError(t, err)
 
-	assert.Equal(t, `{"id":"123","title":"title","body":"a body","type":"DraftIssue"}`, string(b))
+	assert.Equal(t, `{"id":"123","title":"title","body":"a body","type":"DraftIssue","isArchived":false}`, string(b))
 }
 
 func TestJSONProjectItem_Issue(t *testing.T) {
@@ -192,7 +192,7 @@ func TestJSONProjectItem_Issue(t *testing.T) {
 	b, err := JSONProjectItem(item)
 	assert.NoError(t, err)
 
-	assert.Equal(t, `{"id":"123","title":"title","body":"a body","type":"Issue","url":"a-url"}`, string(b))
+	assert.Equal(t, `{"id":"123","title":"title","body":"a body","type":"Issue","url":"a-url","isArchived":false}`, string(b))
 }
 
 func TestJSONProjectItem_PullRequest(t *testing.T) {
@@ -206,7 +206,7 @@ func TestJSONProjectItem_PullRequest(t *testing.T) {
 	b, err := JSONProjectItem(item)
 	assert.NoError(t, err)
 
-	assert.Equal(t, `{"id":"123","title":"title","body":"a body","type":"PullRequest","url":"a-url"}`, string(b))
+	assert.Equal(t, `{"id":"123","title":"title","body":"a body","type":"PullRequest","url":"a-url","isArchived":false}`, string(b))
 }
 
 func TestJSONProjectDetailedItems(t *testing.T) {
@@ -263,7 +263,7 @@ func TestJSONProjectDetailedItems(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(
 		t,
-		`{"items":[{"content":{"type":"Issue","body":"a body","title":"Issue title","number":1,"repository":"cli/go-gh","url":"issue-url"},"id":"issueId"},{"content":{"type":"PullRequest","body":"a body","title":"Pull Request title","number":2,"repository":"cli/go-gh","url":"pr-url"},"id":"pullRequestId"},{"content":{"type":"DraftIssue","body":"a body","title":"Pull Request title"},"id":"draftIssueId"}],"totalCount":5}`,
+		`{"items":[{"content":{"type":"Issue","body":"a body","title":"Issue title","number":1,"repository":"cli/go-gh","url":"issue-url"},"id":"issueId","isArchived":false},{"content":{"type":"PullRequest","body":"a body","title":"Pull Request title","number":2,"repository":"cli/go-gh","url":"pr-url"},"id":"pullRequestId","isArchived":false},{"content":{"type":"DraftIssue","body":"a body","title":"Pull Request title"},"id":"draftIssueId","isArchived":false}],"totalCount":5}`,
 		string(out))
 }
 
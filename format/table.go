@@ -0,0 +1,38 @@
+package format
+
+import (
+	"fmt"
+
+	"github.com/cli/go-gh/v2/pkg/tableprinter"
+	"github.com/github/gh-projects/queries"
+)
+
+// PrintItems renders a table of items with their type, title, number, repository and ID,
+// such as the items a bulk command would act on.
+func PrintItems(tp tableprinter.TablePrinter, items []queries.ProjectItem) error {
+	tp.AddField("Type")
+	tp.AddField("Title")
+	tp.AddField("Number")
+	tp.AddField("Repository")
+	tp.AddField("ID")
+	tp.EndRow()
+
+	for _, i := range items {
+		tp.AddField(i.Type())
+		tp.AddField(i.Title())
+		if i.Number() == 0 {
+			tp.AddField(" - ")
+		} else {
+			tp.AddField(fmt.Sprintf("%d", i.Number()))
+		}
+		if i.Repo() == "" {
+			tp.AddField(" - ")
+		} else {
+			tp.AddField(i.Repo())
+		}
+		tp.AddField(i.ID())
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,100 @@
+package format
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/github/gh-projects/queries"
+)
+
+// FieldValueText returns a human readable representation of a project item field value.
+// Values with multiple entries, such as labels or assignees, are joined with commas.
+func FieldValueText(v queries.FieldValueNodes) string {
+	return strings.Join(FieldValueTexts(v), ", ")
+}
+
+// FieldValueTexts returns the human readable entries of a project item field value.
+// Single values are returned as a slice with one entry.
+func FieldValueTexts(v queries.FieldValueNodes) []string {
+	switch v.Type {
+	case "ProjectV2ItemFieldDateValue":
+		return []string{v.ProjectV2ItemFieldDateValue.Date}
+	case "ProjectV2ItemFieldIterationValue":
+		return []string{v.ProjectV2ItemFieldIterationValue.Title}
+	case "ProjectV2ItemFieldNumberValue":
+		return []string{strconv.FormatFloat(float64(v.ProjectV2ItemFieldNumberValue.Number), 'f', -1, 32)}
+	case "ProjectV2ItemFieldSingleSelectValue":
+		return []string{v.ProjectV2ItemFieldSingleSelectValue.Name}
+	case "ProjectV2ItemFieldTextValue":
+		return []string{v.ProjectV2ItemFieldTextValue.Text}
+	case "ProjectV2ItemFieldMilestoneValue":
+		return []string{v.ProjectV2ItemFieldMilestoneValue.Milestone.Title}
+	case "ProjectV2ItemFieldLabelValue":
+		names := make([]string, 0)
+		for _, p := range v.ProjectV2ItemFieldLabelValue.Labels.Nodes {
+			names = append(names, p.Name)
+		}
+		return names
+	case "ProjectV2ItemFieldPullRequestValue":
+		urls := make([]string, 0)
+		for _, p := range v.ProjectV2ItemFieldPullRequestValue.PullRequests.Nodes {
+			urls = append(urls, p.Url)
+		}
+		return urls
+	case "ProjectV2ItemFieldRepositoryValue":
+		return []string{v.ProjectV2ItemFieldRepositoryValue.Repository.Url}
+	case "ProjectV2ItemFieldUserValue":
+		logins := make([]string, 0)
+		for _, p := range v.ProjectV2ItemFieldUserValue.Users.Nodes {
+			logins = append(logins, p.Login)
+		}
+		return logins
+	case "ProjectV2ItemFieldReviewerValue":
+		names := make([]string, 0)
+		for _, p := range v.ProjectV2ItemFieldReviewerValue.Reviewers.Nodes {
+			if p.Type == "Team" {
+				names = append(names, p.Team.Name)
+			} else if p.Type == "User" {
+				names = append(names, p.User.Login)
+			}
+		}
+		return names
+	}
+
+	return nil
+}
+
+// FieldValuesText returns the human readable field values of a project item keyed by field name.
+func FieldValuesText(item queries.ProjectItem) map[string]string {
+	values := make(map[string]string)
+	for _, v := range item.FieldValues.Nodes {
+		name := v.Field().Name()
+		if name == "" {
+			continue
+		}
+		values[name] = FieldValueText(v)
+	}
+	return values
+}
+
+// ViewLayout returns the name of a ProjectV2ViewLayout as shown in the web UI, such as Board for BOARD_LAYOUT.
+func ViewLayout(layout string) string {
+	switch layout {
+	case "BOARD_LAYOUT":
+		return "Board"
+	case "TABLE_LAYOUT":
+		return "Table"
+	case "ROADMAP_LAYOUT":
+		return "Roadmap"
+	}
+	return layout
+}
+
+// Pluralize formats a count with a noun, such as "1 item" or "2 items".
+func Pluralize(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, noun)
+	}
+	return fmt.Sprintf("%d %ss", count, noun)
+}
@@ -0,0 +1,40 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/github/gh-projects/queries"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldValueText(t *testing.T) {
+	number := queries.FieldValueNodes{Type: "ProjectV2ItemFieldNumberValue"}
+	number.ProjectV2ItemFieldNumberValue.Number = 2.5
+	assert.Equal(t, "2.5", FieldValueText(number))
+
+	iteration := queries.FieldValueNodes{Type: "ProjectV2ItemFieldIterationValue"}
+	iteration.ProjectV2ItemFieldIterationValue.Title = "Sprint 1"
+	assert.Equal(t, "Sprint 1", FieldValueText(iteration))
+
+	users := queries.FieldValueNodes{Type: "ProjectV2ItemFieldUserValue"}
+	users.ProjectV2ItemFieldUserValue.Users.Nodes = append(users.ProjectV2ItemFieldUserValue.Users.Nodes,
+		struct{ Login string }{Login: "monalisa"},
+		struct{ Login string }{Login: "hubot"},
+	)
+	assert.Equal(t, "monalisa, hubot", FieldValueText(users))
+
+	assert.Equal(t, "", FieldValueText(queries.FieldValueNodes{Type: "Unknown"}))
+}
+
+func TestFieldValuesText(t *testing.T) {
+	status := queries.FieldValueNodes{Type: "ProjectV2ItemFieldSingleSelectValue"}
+	status.ProjectV2ItemFieldSingleSelectValue.Name = "Done"
+	status.ProjectV2ItemFieldSingleSelectValue.Field.TypeName = "ProjectV2SingleSelectField"
+	status.ProjectV2ItemFieldSingleSelectValue.Field.SingleSelectField.Name = "Status"
+
+	item := queries.ProjectItem{}
+	item.FieldValues.Nodes = []queries.FieldValueNodes{status, {Type: "Unknown"}}
+
+	assert.Equal(t, map[string]string{"Status": "Done"}, FieldValuesText(item))
+}
@@ -36,6 +36,13 @@ COPY_PROJECT_NUMBER=$(./gh-projects copy $PROJECT_NUMBER --source-org $ORG_NAME
 ./gh-projects field-list $PROJECT_NUMBER --org $ORG_NAME --format=json  | jq .
 FIELD_ID=$(./gh-projects field-create $PROJECT_NUMBER --org $ORG_NAME --data-type TEXT --name custom-text --format=json | jq '.id')
 ./gh-projects field-delete --id $FIELD_ID --format=json | jq .
+./gh-projects schema export $PROJECT_NUMBER --org $ORG_NAME > schema.yaml
+./gh-projects schema apply schema.yaml $PROJECT_NUMBER --org $ORG_NAME --plan
+rm schema.yaml
+./gh-projects collaborator-list $PROJECT_NUMBER --org $ORG_NAME --format=json | jq .
+./gh-projects status-update list $PROJECT_NUMBER --org $ORG_NAME --format=json | jq .
+./gh-projects view-list $PROJECT_NUMBER --org $ORG_NAME --format=json | jq .
+./gh-projects workflow-list $PROJECT_NUMBER --org $ORG_NAME --format=json | jq .
 
 if [[ -n $ITEM_URL ]]; then
     ./gh-projects item-add $PROJECT_NUMBER --org $ORG_NAME --url $ITEM_URL --format=json | jq .
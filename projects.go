@@ -6,22 +6,39 @@ import (
 	"strings"
 
 	"github.com/cli/cli/v2/pkg/cmd/factory"
+	cmdBoard "github.com/github/gh-projects/cmd/board"
 	cmdClose "github.com/github/gh-projects/cmd/close"
+	cmdCollaborator "github.com/github/gh-projects/cmd/collaborator"
 	cmdCopy "github.com/github/gh-projects/cmd/copy"
 	cmdCreate "github.com/github/gh-projects/cmd/create"
 	cmdDelete "github.com/github/gh-projects/cmd/delete"
+	cmdDiff "github.com/github/gh-projects/cmd/diff"
 	cmdEdit "github.com/github/gh-projects/cmd/edit"
 	cmdFieldCreate "github.com/github/gh-projects/cmd/field-create"
 	cmdFieldDelete "github.com/github/gh-projects/cmd/field-delete"
 	cmdFieldList "github.com/github/gh-projects/cmd/field-list"
 	cmdItemAdd "github.com/github/gh-projects/cmd/item-add"
 	cmdItemArchive "github.com/github/gh-projects/cmd/item-archive"
+	cmdItemConvert "github.com/github/gh-projects/cmd/item-convert"
 	cmdItemCreate "github.com/github/gh-projects/cmd/item-create"
 	cmdItemDelete "github.com/github/gh-projects/cmd/item-delete"
 	cmdItemEdit "github.com/github/gh-projects/cmd/item-edit"
 	cmdItemList "github.com/github/gh-projects/cmd/item-list"
+	cmdItemMove "github.com/github/gh-projects/cmd/item-move"
+	cmdLink "github.com/github/gh-projects/cmd/link"
 	cmdList "github.com/github/gh-projects/cmd/list"
+	cmdMarkTemplate "github.com/github/gh-projects/cmd/mark-template"
+	cmdReorder "github.com/github/gh-projects/cmd/reorder"
+	cmdSchema "github.com/github/gh-projects/cmd/schema"
+	cmdStatusUpdate "github.com/github/gh-projects/cmd/status-update"
+	cmdSync "github.com/github/gh-projects/cmd/sync"
 	cmdView "github.com/github/gh-projects/cmd/view"
+	cmdViewList "github.com/github/gh-projects/cmd/view-list"
+	cmdViewShow "github.com/github/gh-projects/cmd/view-show"
+	cmdWatch "github.com/github/gh-projects/cmd/watch"
+	cmdWorkflowDelete "github.com/github/gh-projects/cmd/workflow-delete"
+	cmdWorkflowList "github.com/github/gh-projects/cmd/workflow-list"
+	"github.com/github/gh-projects/queries"
 	"github.com/spf13/cobra"
 )
 
@@ -34,6 +51,14 @@ func main() {
 		SilenceErrors: true,
 	}
 
+	var noPrompt bool
+	rootCmd.PersistentFlags().BoolVar(&noPrompt, "no-prompt", false, "Disable interactive prompts. Can also be set with the GH_PROMPT_DISABLED environment variable.")
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		if noPrompt {
+			queries.DisablePrompts()
+		}
+	}
+
 	cmdFactory := factory.New("0.1.0") // will be replaced by buildVersion := build.Version
 
 	rootCmd.AddCommand(cmdList.NewCmdList(cmdFactory, nil))
@@ -43,6 +68,21 @@ func main() {
 	rootCmd.AddCommand(cmdDelete.NewCmdDelete(cmdFactory, nil))
 	rootCmd.AddCommand(cmdEdit.NewCmdEdit(cmdFactory, nil))
 	rootCmd.AddCommand(cmdView.NewCmdView(cmdFactory, nil))
+	rootCmd.AddCommand(cmdDiff.NewCmdDiff(cmdFactory, nil))
+	rootCmd.AddCommand(cmdMarkTemplate.NewCmdMarkTemplate(cmdFactory, nil))
+	rootCmd.AddCommand(cmdMarkTemplate.NewCmdUnmarkTemplate(cmdFactory, nil))
+	rootCmd.AddCommand(cmdLink.NewCmdLink(cmdFactory, nil))
+	rootCmd.AddCommand(cmdLink.NewCmdUnlink(cmdFactory, nil))
+	rootCmd.AddCommand(cmdCollaborator.NewCmdList(cmdFactory, nil))
+	rootCmd.AddCommand(cmdCollaborator.NewCmdAdd(cmdFactory, nil))
+	rootCmd.AddCommand(cmdCollaborator.NewCmdRemove(cmdFactory, nil))
+	rootCmd.AddCommand(cmdStatusUpdate.NewCmdStatusUpdate(cmdFactory))
+	rootCmd.AddCommand(cmdViewList.NewCmdList(cmdFactory, nil))
+	rootCmd.AddCommand(cmdViewShow.NewCmdShow(cmdFactory, nil))
+	rootCmd.AddCommand(cmdWorkflowList.NewCmdList(cmdFactory, nil))
+	rootCmd.AddCommand(cmdWorkflowDelete.NewCmdDeleteWorkflow(cmdFactory, nil))
+	rootCmd.AddCommand(cmdBoard.NewCmdBoard(cmdFactory, nil))
+	rootCmd.AddCommand(cmdWatch.NewCmdWatch(cmdFactory, nil))
 
 	// items
 	rootCmd.AddCommand(cmdItemList.NewCmdList(cmdFactory, nil))
@@ -51,11 +91,16 @@ func main() {
 	rootCmd.AddCommand(cmdItemEdit.NewCmdEditItem(cmdFactory, nil))
 	rootCmd.AddCommand(cmdItemArchive.NewCmdArchiveItem(cmdFactory, nil))
 	rootCmd.AddCommand(cmdItemDelete.NewCmdDeleteItem(cmdFactory, nil))
+	rootCmd.AddCommand(cmdItemConvert.NewCmdConvertItem(cmdFactory, nil))
+	rootCmd.AddCommand(cmdItemMove.NewCmdMoveItem(cmdFactory, nil))
+	rootCmd.AddCommand(cmdReorder.NewCmdReorder(cmdFactory, nil))
+	rootCmd.AddCommand(cmdSync.NewCmdSync(cmdFactory, nil))
 
 	// fields
 	rootCmd.AddCommand(cmdFieldList.NewCmdList(cmdFactory, nil))
 	rootCmd.AddCommand(cmdFieldCreate.NewCmdCreateField(cmdFactory, nil))
 	rootCmd.AddCommand(cmdFieldDelete.NewCmdDeleteField(cmdFactory, nil))
+	rootCmd.AddCommand(cmdSchema.NewCmdSchema(cmdFactory))
 
 	if err := rootCmd.Execute(); err != nil {
 		if strings.HasPrefix(err.Error(), "Message: Your token has not been granted the required scopes to execute this query") {
@@ -0,0 +1,59 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+// BulkBatchSize is the number of aliased mutations sent in a single request by BulkItemMutation.
+const BulkBatchSize = 50
+
+// BulkItemMutation runs a mutation taking a projectId and an itemId, such as archiveProjectV2Item,
+// on each of the items of a project. The mutations are aliased and sent in batches of BulkBatchSize.
+// It returns the number of items processed, which is less than len(itemIDs) if a batch failed.
+// The items of a failed batch that were processed are counted, as the API still returns their results.
+func BulkItemMutation(client *api.GraphQLClient, name string, mutation string, projectID string, itemIDs []string) (int, error) {
+	done := 0
+	for start := 0; start < len(itemIDs); start += BulkBatchSize {
+		end := start + BulkBatchSize
+		if end > len(itemIDs) {
+			end = len(itemIDs)
+		}
+
+		query, variables := bulkItemMutationArgs(name, mutation, projectID, itemIDs[start:end])
+		var response map[string]interface{}
+		err := client.Do(query, variables, &response)
+		if err != nil {
+			// the aliases of the items that failed are null
+			for i := range itemIDs[start:end] {
+				if response[fmt.Sprintf("item%d", i)] != nil {
+					done++
+				}
+			}
+			return done, err
+		}
+		done = end
+	}
+	return done, nil
+}
+
+// bulkItemMutationArgs builds a mutation with an aliased field per item, such as
+// `item0: archiveProjectV2Item(input: {projectId: $projectId, itemId: $item0}) { clientMutationId }`.
+func bulkItemMutationArgs(name string, mutation string, projectID string, itemIDs []string) (string, map[string]interface{}) {
+	params := []string{"$projectId: ID!"}
+	fields := make([]string, 0, len(itemIDs))
+	variables := map[string]interface{}{
+		"projectId": projectID,
+	}
+	for i, id := range itemIDs {
+		alias := fmt.Sprintf("item%d", i)
+		params = append(params, fmt.Sprintf("$%s: ID!", alias))
+		fields = append(fields, fmt.Sprintf("%s: %s(input: {projectId: $projectId, itemId: $%s}) { clientMutationId }", alias, mutation, alias))
+		variables[alias] = id
+	}
+
+	query := fmt.Sprintf("mutation %s(%s) { %s }", name, strings.Join(params, ", "), strings.Join(fields, " "))
+	return query, variables
+}
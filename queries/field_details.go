@@ -0,0 +1,99 @@
+package queries
+
+import (
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectFieldWithDetails is a ProjectField along with the colors and descriptions of its single select
+// options and the configuration of its iterations. ProjectField is part of every field value of every
+// item, so these details are only fetched, with ProjectFieldsWithDetails, by the commands that need them.
+type ProjectFieldWithDetails struct {
+	ProjectField
+	IterationFieldDetails struct {
+		Configuration IterationFieldConfiguration
+	} `graphql:"... on ProjectV2IterationField"`
+	SingleSelectFieldDetails struct {
+		Options []SingleSelectFieldOptionDetails
+	} `graphql:"... on ProjectV2SingleSelectField"`
+}
+
+// SingleSelectFieldOptionDetails is a ProjectV2SingleSelectFieldOption GraphQL object https://docs.github.com/en/graphql/reference/objects#projectv2singleselectfieldoption.
+type SingleSelectFieldOptionDetails struct {
+	ID          string
+	Name        string
+	Color       string
+	Description string
+}
+
+// IterationFieldConfiguration is a ProjectV2IterationFieldConfiguration GraphQL object https://docs.github.com/en/graphql/reference/objects#projectv2iterationfieldconfiguration.
+type IterationFieldConfiguration struct {
+	Duration            int
+	StartDay            int
+	Iterations          []IterationFieldIteration
+	CompletedIterations []IterationFieldIteration
+}
+
+// IterationFieldIteration is a ProjectV2IterationFieldIteration GraphQL object https://docs.github.com/en/graphql/reference/objects#projectv2iterationfielditeration.
+type IterationFieldIteration struct {
+	ID        string
+	Title     string
+	StartDate string
+	Duration  int
+}
+
+// OptionDetails returns the options of a single select field, with their colors and descriptions.
+func (p ProjectFieldWithDetails) OptionDetails() []SingleSelectFieldOptionDetails {
+	if p.TypeName == "ProjectV2SingleSelectField" {
+		return p.SingleSelectFieldDetails.Options
+	}
+	return nil
+}
+
+// Iterations returns the active and completed iterations of an iteration field.
+func (p ProjectFieldWithDetails) Iterations() []IterationFieldIteration {
+	if p.TypeName == "ProjectV2IterationField" {
+		var iterations []IterationFieldIteration
+		iterations = append(iterations, p.IterationFieldDetails.Configuration.Iterations...)
+		iterations = append(iterations, p.IterationFieldDetails.Configuration.CompletedIterations...)
+		return iterations
+	}
+	return nil
+}
+
+// projectFieldsWithDetails is used to query a page of the fields of a project with their details.
+type projectFieldsWithDetails struct {
+	Node struct {
+		Project struct {
+			Fields struct {
+				PageInfo PageInfo
+				Nodes    []ProjectFieldWithDetails
+			} `graphql:"fields(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectFieldsWithDetails returns all the fields of a project with their details.
+func ProjectFieldsWithDetails(client *api.GraphQLClient, projectID string) ([]ProjectFieldWithDetails, error) {
+	variables := map[string]interface{}{
+		"id":    githubv4.ID(projectID),
+		"first": githubv4.Int(LimitMax),
+		"after": (*githubv4.String)(nil),
+	}
+
+	fields := make([]ProjectFieldWithDetails, 0)
+	for {
+		var query projectFieldsWithDetails
+		err := doQuery(client, "ProjectFieldsWithDetails", &query, variables)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, query.Node.Project.Fields.Nodes...)
+		if !query.Node.Project.Fields.PageInfo.HasNextPage {
+			return fields, nil
+		}
+		// set the cursor to the end of the last page
+		cursor := query.Node.Project.Fields.PageInfo.EndCursor
+		variables["after"] = &cursor
+	}
+}
@@ -0,0 +1,134 @@
+package queries
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// FieldValueInput is a value to set on a project field, parsed from an assignment such as Status=Done.
+type FieldValueInput struct {
+	Field ProjectField
+	// Text is the value as shown in the project, such as the name of a single select option.
+	Text  string
+	Value githubv4.ProjectV2FieldValue
+}
+
+// ParseFieldValue parses an assignment of the form FIELD=VALUE against the fields of a project.
+// Field names are compared case-insensitively. Single select values are option names and
+// iteration values are iteration titles, so no IDs are needed. Only text, number, date,
+// single select and iteration fields can be set.
+func ParseFieldValue(assignment string, fields []ProjectFieldWithDetails) (*FieldValueInput, error) {
+	name, value, ok := strings.Cut(assignment, "=")
+	if !ok || name == "" {
+		return nil, fmt.Errorf("invalid field value %q, must be of the form FIELD=VALUE", assignment)
+	}
+
+	var field *ProjectFieldWithDetails
+	for i, f := range fields {
+		if strings.EqualFold(f.Name(), name) {
+			field = &fields[i]
+			break
+		}
+	}
+	if field == nil {
+		return nil, fmt.Errorf("invalid field value %q, no field named %q", assignment, name)
+	}
+
+	input := &FieldValueInput{Field: field.ProjectField, Text: value}
+	switch field.DataType() {
+	case "TEXT":
+		input.Value.Text = githubv4.NewString(githubv4.String(value))
+	case "NUMBER":
+		number, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field value %q, %s must be a number", assignment, field.Name())
+		}
+		input.Value.Number = githubv4.NewFloat(githubv4.Float(number))
+	case "DATE":
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field value %q, %s must be a date of the form YYYY-MM-DD", assignment, field.Name())
+		}
+		input.Value.Date = githubv4.NewDate(githubv4.Date{Time: date})
+	case "SINGLE_SELECT":
+		names := make([]string, 0)
+		for _, o := range field.Options() {
+			if strings.EqualFold(o.Name, value) {
+				input.Text = o.Name
+				input.Value.SingleSelectOptionID = githubv4.NewString(githubv4.String(o.ID))
+				return input, nil
+			}
+			names = append(names, o.Name)
+		}
+		return nil, fmt.Errorf("invalid field value %q, %s must be one of %s", assignment, field.Name(), strings.Join(names, ", "))
+	case "ITERATION":
+		titles := make([]string, 0)
+		for _, i := range field.Iterations() {
+			if strings.EqualFold(i.Title, value) {
+				input.Text = i.Title
+				input.Value.IterationID = githubv4.NewString(githubv4.String(i.ID))
+				return input, nil
+			}
+			titles = append(titles, i.Title)
+		}
+		return nil, fmt.Errorf("invalid field value %q, %s must be one of %s", assignment, field.Name(), strings.Join(titles, ", "))
+	default:
+		return nil, fmt.Errorf("invalid field value %q, %s fields cannot be set", assignment, strings.ToLower(field.DataType()))
+	}
+	return input, nil
+}
+
+// ParseFieldValues parses assignments of the form FIELD=VALUE. See ParseFieldValue.
+func ParseFieldValues(assignments []string, fields []ProjectFieldWithDetails) ([]FieldValueInput, error) {
+	inputs := make([]FieldValueInput, 0, len(assignments))
+	for _, a := range assignments {
+		input, err := ParseFieldValue(a, fields)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, *input)
+	}
+	return inputs, nil
+}
+
+// ProjectFieldValues parses assignments of the form FIELD=VALUE against the fields of a project,
+// which are only fetched when there are assignments. See ParseFieldValue.
+func ProjectFieldValues(client *api.GraphQLClient, projectID string, assignments []string) ([]FieldValueInput, error) {
+	if len(assignments) == 0 {
+		return []FieldValueInput{}, nil
+	}
+	fields, err := ProjectFieldsWithDetails(client, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFieldValues(assignments, fields)
+}
+
+type updateItemFieldValueMutation struct {
+	Update struct {
+		Item ProjectItem `graphql:"projectV2Item"`
+	} `graphql:"updateProjectV2ItemFieldValue(input:$input)"`
+}
+
+// SetFieldValue sets a field value on an item of a project and returns the updated item.
+func SetFieldValue(client *api.GraphQLClient, projectID string, itemID string, input FieldValueInput) (*ProjectItem, error) {
+	var mutation updateItemFieldValueMutation
+	variables := map[string]interface{}{
+		"input": githubv4.UpdateProjectV2ItemFieldValueInput{
+			ProjectID: githubv4.ID(projectID),
+			ItemID:    githubv4.ID(itemID),
+			FieldID:   githubv4.ID(input.Field.ID()),
+			Value:     input.Value,
+		},
+	}
+	err := client.Mutate("UpdateItemFieldValue", &mutation, variables)
+	if err != nil {
+		return nil, err
+	}
+	return &mutation.Update.Item, nil
+}
@@ -0,0 +1,102 @@
+package queries
+
+import (
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// CreateProjectV2FieldInput is an input type of CreateProjectV2Field.
+// It extends githubv4.CreateProjectV2FieldInput, which does not support iteration fields.
+type CreateProjectV2FieldInput struct {
+	ProjectID              githubv4.ID                                `json:"projectId"`
+	DataType               githubv4.ProjectV2CustomFieldType          `json:"dataType"`
+	Name                   githubv4.String                            `json:"name"`
+	SingleSelectOptions    *[]ProjectV2SingleSelectFieldOptionInput   `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *ProjectV2IterationFieldConfigurationInput `json:"iterationConfiguration,omitempty"`
+}
+
+// UpdateProjectV2FieldInput is an input type of UpdateProjectV2Field.
+type UpdateProjectV2FieldInput struct {
+	FieldID                githubv4.ID                                `json:"fieldId"`
+	SingleSelectOptions    *[]ProjectV2SingleSelectFieldOptionInput   `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *ProjectV2IterationFieldConfigurationInput `json:"iterationConfiguration,omitempty"`
+}
+
+// ProjectV2SingleSelectFieldOptionInput is an option of a single select field.
+// It extends githubv4.ProjectV2SingleSelectFieldOptionInput with the ID of an existing option.
+type ProjectV2SingleSelectFieldOptionInput struct {
+	ID          *githubv4.ID                                   `json:"id,omitempty"`
+	Name        githubv4.String                                `json:"name"`
+	Color       githubv4.ProjectV2SingleSelectFieldOptionColor `json:"color"`
+	Description githubv4.String                                `json:"description"`
+}
+
+// ProjectV2IterationFieldConfigurationInput is the configuration of an iteration field.
+type ProjectV2IterationFieldConfigurationInput struct {
+	Duration   githubv4.Int         `json:"duration"`
+	StartDate  githubv4.String      `json:"startDate"`
+	Iterations []ProjectV2Iteration `json:"iterations"`
+}
+
+// ProjectV2Iteration is a single iteration of an iteration field.
+type ProjectV2Iteration struct {
+	Title     githubv4.String `json:"title"`
+	StartDate githubv4.String `json:"startDate"`
+	Duration  githubv4.Int    `json:"duration"`
+}
+
+type createProjectV2FieldMutation struct {
+	CreateProjectV2Field struct {
+		Field ProjectField `graphql:"projectV2Field"`
+	} `graphql:"createProjectV2Field(input:$input)"`
+}
+
+type updateProjectV2FieldMutation struct {
+	UpdateProjectV2Field struct {
+		Field ProjectField `graphql:"projectV2Field"`
+	} `graphql:"updateProjectV2Field(input:$input)"`
+}
+
+type deleteProjectV2FieldMutation struct {
+	DeleteProjectV2Field struct {
+		Field ProjectField `graphql:"projectV2Field"`
+	} `graphql:"deleteProjectV2Field(input:$input)"`
+}
+
+// CreateField creates a field in a project and returns it.
+func CreateField(client *api.GraphQLClient, input CreateProjectV2FieldInput) (*ProjectField, error) {
+	var mutation createProjectV2FieldMutation
+	err := client.Mutate("CreateField", &mutation, map[string]interface{}{
+		"input": input,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mutation.CreateProjectV2Field.Field, nil
+}
+
+// UpdateField replaces the single select options or the iterations of a field and returns it.
+func UpdateField(client *api.GraphQLClient, input UpdateProjectV2FieldInput) (*ProjectField, error) {
+	var mutation updateProjectV2FieldMutation
+	err := client.Mutate("UpdateField", &mutation, map[string]interface{}{
+		"input": input,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mutation.UpdateProjectV2Field.Field, nil
+}
+
+// DeleteField deletes a field of a project and returns it.
+func DeleteField(client *api.GraphQLClient, fieldID string) (*ProjectField, error) {
+	var mutation deleteProjectV2FieldMutation
+	err := client.Mutate("DeleteField", &mutation, map[string]interface{}{
+		"input": githubv4.DeleteProjectV2FieldInput{
+			FieldID: githubv4.ID(fieldID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mutation.DeleteProjectV2Field.Field, nil
+}
@@ -0,0 +1,48 @@
+package queries
+
+import (
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+type moveProjectItemMutation struct {
+	MoveProjectItem struct {
+		ClientMutationId string
+	} `graphql:"updateProjectV2ItemPosition(input:$input)"`
+}
+
+// MoveItem moves an item of a project after another item, or to the top if afterID is empty.
+func MoveItem(client *api.GraphQLClient, projectID string, itemID string, afterID string) error {
+	input := githubv4.UpdateProjectV2ItemPositionInput{
+		ProjectID: githubv4.ID(projectID),
+		ItemID:    githubv4.ID(itemID),
+	}
+	if afterID != "" {
+		input.AfterID = githubv4.NewID(githubv4.ID(afterID))
+	}
+	var mutation moveProjectItemMutation
+	return client.Mutate("MoveProjectItem", &mutation, map[string]interface{}{
+		"input": input,
+	})
+}
+
+type addProjectItemMutation struct {
+	AddProjectItem struct {
+		Item ProjectItem `graphql:"item"`
+	} `graphql:"addProjectV2ItemById(input:$input)"`
+}
+
+// AddProjectItem adds an issue or pull request to a project and returns the new item.
+func AddProjectItem(client *api.GraphQLClient, projectID string, contentID string) (*ProjectItem, error) {
+	var mutation addProjectItemMutation
+	err := client.Mutate("AddItem", &mutation, map[string]interface{}{
+		"input": githubv4.AddProjectV2ItemByIdInput{
+			ProjectID: githubv4.ID(projectID),
+			ContentID: githubv4.ID(contentID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mutation.AddProjectItem.Item, nil
+}
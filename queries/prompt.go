@@ -0,0 +1,128 @@
+package queries
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/cli/go-gh/v2/pkg/term"
+)
+
+// Prompter asks the user to make a choice or enter a value interactively.
+type Prompter interface {
+	// Select returns the index of the option chosen by the user.
+	Select(message string, options []string) (int, error)
+	// Input returns the value entered by the user. validate may be nil.
+	Input(message string, validate func(string) error) (string, error)
+	// Confirm returns whether the user answered yes.
+	Confirm(message string) (bool, error)
+}
+
+var (
+	prompter       Prompter
+	promptDisabled bool
+)
+
+// SetPrompter replaces the prompter used by NewOwner, NewProject and the other prompts, which
+// otherwise prompt on the standard streams. Passing nil restores the default.
+func SetPrompter(p Prompter) {
+	prompter = p
+}
+
+// DisablePrompts makes every prompt fail with an error naming the flag to use instead.
+func DisablePrompts() {
+	promptDisabled = true
+}
+
+// CanPrompt reports whether the user can be prompted. This is not the case when prompts are disabled
+// with DisablePrompts or GH_PROMPT_DISABLED, or when stdin or stdout is not a terminal and no prompter was set.
+func CanPrompt() bool {
+	if promptDisabled || os.Getenv("GH_PROMPT_DISABLED") != "" {
+		return false
+	}
+	if prompter != nil {
+		return true
+	}
+	return term.IsTerminal(os.Stdin) && term.IsTerminal(os.Stdout)
+}
+
+// Select prompts the user to choose one of options and returns its index.
+// flag describes how to provide the answer when prompting is not possible.
+func Select(message string, options []string, flag string) (int, error) {
+	if !CanPrompt() {
+		return 0, NoPromptError(flag)
+	}
+	return currentPrompter().Select(message, options)
+}
+
+// Input prompts the user to enter a value.
+// flag describes how to provide the answer when prompting is not possible.
+func Input(message string, validate func(string) error, flag string) (string, error) {
+	if !CanPrompt() {
+		return "", NoPromptError(flag)
+	}
+	return currentPrompter().Input(message, validate)
+}
+
+// Confirm asks the user a yes or no question, defaulting to no.
+// flag describes how to provide the answer when prompting is not possible.
+func Confirm(message string, flag string) (bool, error) {
+	if !CanPrompt() {
+		return false, NoPromptError(flag)
+	}
+	return currentPrompter().Confirm(message)
+}
+
+// NoPromptError is the error returned instead of prompting when CanPrompt is false.
+// flag describes how to provide the answer, such as "use --id to select the item".
+func NoPromptError(flag string) error {
+	return fmt.Errorf("cannot prompt in non-interactive mode, %s", flag)
+}
+
+func currentPrompter() Prompter {
+	if prompter != nil {
+		return prompter
+	}
+	return NewSurveyPrompter(os.Stdin, os.Stdout, os.Stderr)
+}
+
+type surveyPrompter struct {
+	stdio terminal.Stdio
+}
+
+// NewSurveyPrompter returns a Prompter that uses survey on the given streams.
+func NewSurveyPrompter(in terminal.FileReader, out terminal.FileWriter, errOut io.Writer) Prompter {
+	return &surveyPrompter{
+		stdio: terminal.Stdio{In: in, Out: out, Err: errOut},
+	}
+}
+
+func (p *surveyPrompter) Select(message string, options []string) (int, error) {
+	answerIndex := 0
+	err := survey.AskOne(&survey.Select{
+		Message: message,
+		Options: options,
+	}, &answerIndex, survey.WithValidator(survey.Required), survey.WithStdio(p.stdio.In, p.stdio.Out, p.stdio.Err))
+	return answerIndex, err
+}
+
+func (p *surveyPrompter) Input(message string, validate func(string) error) (string, error) {
+	opts := []survey.AskOpt{survey.WithStdio(p.stdio.In, p.stdio.Out, p.stdio.Err)}
+	if validate != nil {
+		opts = append(opts, survey.WithValidator(func(ans interface{}) error {
+			return validate(fmt.Sprint(ans))
+		}))
+	}
+
+	var answer string
+	err := survey.AskOne(&survey.Input{Message: message}, &answer, opts...)
+	return answer, err
+}
+
+func (p *surveyPrompter) Confirm(message string) (bool, error) {
+	var answer bool
+	err := survey.AskOne(&survey.Confirm{Message: message}, &answer, survey.WithStdio(p.stdio.In, p.stdio.Out, p.stdio.Err))
+	return answer, err
+}
@@ -3,10 +3,10 @@ package queries
 import (
 	"errors"
 	"fmt"
-	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/briandowns/spinner"
 	"github.com/cli/go-gh/v2/pkg/api"
 	"github.com/shurcooL/githubv4"
@@ -56,6 +56,7 @@ type Project struct {
 	ShortDescription string
 	Public           bool
 	Closed           bool
+	Template         bool
 	Title            string
 	ID               string
 	Readme           string
@@ -95,6 +96,9 @@ func (p Project) OwnerLogin() string {
 type ProjectItem struct {
 	Content     ProjectItemContent
 	Id          string
+	IsArchived  bool
+	CreatedAt   string
+	UpdatedAt   string
 	FieldValues struct {
 		Nodes []FieldValueNodes
 	} `graphql:"fieldValues(first: 100)"` // hardcoded to 100 for now on the assumption that this is a reasonable limit
@@ -114,9 +118,11 @@ type FieldValueNodes struct {
 		Field ProjectField
 	} `graphql:"... on ProjectV2ItemFieldDateValue"`
 	ProjectV2ItemFieldIterationValue struct {
-		StartDate string
-		Duration  int
-		Field     ProjectField
+		Title       string
+		StartDate   string
+		Duration    int
+		IterationId string
+		Field       ProjectField
 	} `graphql:"... on ProjectV2ItemFieldIterationValue"`
 	ProjectV2ItemFieldLabelValue struct {
 		Labels struct {
@@ -131,8 +137,9 @@ type FieldValueNodes struct {
 		Field  ProjectField
 	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
 	ProjectV2ItemFieldSingleSelectValue struct {
-		Name  string
-		Field ProjectField
+		Name     string
+		OptionId string
+		Field    ProjectField
 	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
 	ProjectV2ItemFieldTextValue struct {
 		Text  string
@@ -140,6 +147,7 @@ type FieldValueNodes struct {
 	} `graphql:"... on ProjectV2ItemFieldTextValue"`
 	ProjectV2ItemFieldMilestoneValue struct {
 		Milestone struct {
+			Title       string
 			Description string
 			DueOn       string
 		}
@@ -183,33 +191,39 @@ type FieldValueNodes struct {
 	} `graphql:"... on ProjectV2ItemFieldReviewerValue"`
 }
 
+// ID is the ID of the field the value belongs to.
 func (v FieldValueNodes) ID() string {
+	return v.Field().ID()
+}
+
+// Field is the project field the value belongs to.
+func (v FieldValueNodes) Field() ProjectField {
 	switch v.Type {
 	case "ProjectV2ItemFieldDateValue":
-		return v.ProjectV2ItemFieldDateValue.Field.ID()
+		return v.ProjectV2ItemFieldDateValue.Field
 	case "ProjectV2ItemFieldIterationValue":
-		return v.ProjectV2ItemFieldIterationValue.Field.ID()
+		return v.ProjectV2ItemFieldIterationValue.Field
 	case "ProjectV2ItemFieldNumberValue":
-		return v.ProjectV2ItemFieldNumberValue.Field.ID()
+		return v.ProjectV2ItemFieldNumberValue.Field
 	case "ProjectV2ItemFieldSingleSelectValue":
-		return v.ProjectV2ItemFieldSingleSelectValue.Field.ID()
+		return v.ProjectV2ItemFieldSingleSelectValue.Field
 	case "ProjectV2ItemFieldTextValue":
-		return v.ProjectV2ItemFieldTextValue.Field.ID()
+		return v.ProjectV2ItemFieldTextValue.Field
 	case "ProjectV2ItemFieldMilestoneValue":
-		return v.ProjectV2ItemFieldMilestoneValue.Field.ID()
+		return v.ProjectV2ItemFieldMilestoneValue.Field
 	case "ProjectV2ItemFieldLabelValue":
-		return v.ProjectV2ItemFieldLabelValue.Field.ID()
+		return v.ProjectV2ItemFieldLabelValue.Field
 	case "ProjectV2ItemFieldPullRequestValue":
-		return v.ProjectV2ItemFieldPullRequestValue.Field.ID()
+		return v.ProjectV2ItemFieldPullRequestValue.Field
 	case "ProjectV2ItemFieldRepositoryValue":
-		return v.ProjectV2ItemFieldRepositoryValue.Field.ID()
+		return v.ProjectV2ItemFieldRepositoryValue.Field
 	case "ProjectV2ItemFieldUserValue":
-		return v.ProjectV2ItemFieldUserValue.Field.ID()
+		return v.ProjectV2ItemFieldUserValue.Field
 	case "ProjectV2ItemFieldReviewerValue":
-		return v.ProjectV2ItemFieldReviewerValue.Field.ID()
+		return v.ProjectV2ItemFieldReviewerValue.Field
 	}
 
-	return ""
+	return ProjectField{}
 }
 
 type DraftIssue struct {
@@ -223,6 +237,7 @@ type PullRequest struct {
 	Title      string
 	Number     int
 	URL        string
+	State      string
 	Repository struct {
 		NameWithOwner string
 	}
@@ -233,6 +248,7 @@ type Issue struct {
 	Title      string
 	Number     int
 	URL        string
+	State      string
 	Repository struct {
 		NameWithOwner string
 	}
@@ -281,6 +297,17 @@ func (p ProjectItem) Number() int {
 	return 0
 }
 
+// State is the state of the project item, such as OPEN, CLOSED or MERGED. It is only valid for issues and pull requests.
+func (p ProjectItem) State() string {
+	switch p.Content.TypeName {
+	case "Issue":
+		return p.Content.Issue.State
+	case "PullRequest":
+		return p.Content.PullRequest.State
+	}
+	return ""
+}
+
 // ID is the id of the ProjectItem.
 func (p ProjectItem) ID() string {
 	return p.Id
@@ -557,6 +584,18 @@ func (p ProjectField) Type() string {
 	return p.TypeName
 }
 
+// DataType is the data type of the project field, such as TEXT or SINGLE_SELECT.
+func (p ProjectField) DataType() string {
+	if p.TypeName == "ProjectV2Field" {
+		return p.Field.DataType
+	} else if p.TypeName == "ProjectV2IterationField" {
+		return p.IterationField.DataType
+	} else if p.TypeName == "ProjectV2SingleSelectField" {
+		return p.SingleSelectField.DataType
+	}
+	return ""
+}
+
 type SingleSelectFieldOptions struct {
 	ID   string
 	Name string
@@ -767,39 +806,41 @@ func OwnerID(client *api.GraphQLClient, login string, t OwnerType) (string, erro
 	return "", errors.New("unknown owner type")
 }
 
-// issueOrPullRequest is used to query the global id of an issue or pull request by its URL.
-type issueOrPullRequest struct {
-	Resource struct {
-		Typename string `graphql:"__typename"`
-		Issue    struct {
-			ID string
-		} `graphql:"... on Issue"`
-		PullRequest struct {
-			ID string
-		} `graphql:"... on PullRequest"`
-	} `graphql:"resource(url: $url)"`
+// UserIDs returns the IDs of users by login, such as the assignees of a draft issue.
+// The login "@me" is the viewer.
+func UserIDs(client *api.GraphQLClient, logins []string) ([]string, error) {
+	ids := make([]string, 0, len(logins))
+	for _, login := range logins {
+		t := UserOwner
+		if login == "@me" {
+			t = ViewerOwner
+		}
+		id, err := OwnerID(client, login, t)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
 }
 
-// IssueOrPullRequestID returns the ID of the issue or pull request from a URL.
-func IssueOrPullRequestID(client *api.GraphQLClient, rawURL string) (string, error) {
-	uri, err := url.Parse(rawURL)
-	if err != nil {
-		return "", err
-	}
-	variables := map[string]interface{}{
-		"url": githubv4.URI{URL: uri},
-	}
-	var query issueOrPullRequest
-	err = doQuery(client, "GetIssueOrPullRequest", &query, variables)
+// draftIssueQuery is used to query a draft issue by its ID.
+type draftIssueQuery struct {
+	Node struct {
+		DraftIssue DraftIssue `graphql:"... on DraftIssue"`
+	} `graphql:"node(id: $id)"`
+}
+
+// DraftIssueByID returns the draft issue with an ID prefixed with `DI_`.
+func DraftIssueByID(client *api.GraphQLClient, id string) (*DraftIssue, error) {
+	var query draftIssueQuery
+	err := doQuery(client, "DraftIssue", &query, map[string]interface{}{
+		"id": githubv4.ID(id),
+	})
 	if err != nil {
-		return "", err
-	}
-	if query.Resource.Typename == "Issue" {
-		return query.Resource.Issue.ID, nil
-	} else if query.Resource.Typename == "PullRequest" {
-		return query.Resource.PullRequest.ID, nil
+		return nil, err
 	}
-	return "", errors.New("resource not found, please check the URL")
+	return &query.Node.DraftIssue, nil
 }
 
 // userProjects queries the $first projects of a user.
@@ -960,6 +1001,10 @@ func NewOwner(client *api.GraphQLClient, userLogin, orgLogin string) (*Owner, er
 		}, nil
 	}
 
+	if !CanPrompt() {
+		return nil, NoPromptError("use --user or --org to select the owner")
+	}
+
 	logins, err := userOrgLogins(client)
 	if err != nil {
 		return nil, err
@@ -970,19 +1015,7 @@ func NewOwner(client *api.GraphQLClient, userLogin, orgLogin string) (*Owner, er
 		options = append(options, l.Login)
 	}
 
-	var q = []*survey.Question{
-		{
-			Name: "owner",
-			Prompt: &survey.Select{
-				Message: "Which owner would you like to use?",
-				Options: options,
-			},
-			Validate: survey.Required,
-		},
-	}
-
-	answerIndex := 0
-	err = survey.Ask(q, &answerIndex)
+	answerIndex, err := Select("Which owner would you like to use?", options, "use --user or --org to select the owner")
 	if err != nil {
 		return nil, err
 	}
@@ -1030,6 +1063,10 @@ func NewProject(client *api.GraphQLClient, o *Owner, number int, fields bool) (*
 		return nil, errors.New("unknown owner type")
 	}
 
+	if !CanPrompt() {
+		return nil, NoPromptError("pass the project number as an argument")
+	}
+
 	projects, _, err := Projects(client, o.Login, o.Type, 0, fields)
 	if err != nil {
 		return nil, err
@@ -1045,24 +1082,53 @@ func NewProject(client *api.GraphQLClient, o *Owner, number int, fields bool) (*
 		options = append(options, title)
 	}
 
-	var q = []*survey.Question{
-		{
-			Name: "project",
-			Prompt: &survey.Select{
-				Message: "Which project would you like to use?",
-				Options: options,
-			},
-			Validate: survey.Required,
-		},
+	answerIndex, err := Select("Which project would you like to use?", options, "pass the project number as an argument")
+	if err != nil {
+		return nil, err
 	}
 
-	answerIndex := 0
-	err = survey.Ask(q, &answerIndex)
+	return &projects[answerIndex], nil
+}
+
+// NewItem prompts the user to select an item of the project interactively.
+func NewItem(client *api.GraphQLClient, o *Owner, number int) (*ProjectItem, error) {
+	if !CanPrompt() {
+		return nil, NoPromptError("use --id to select the item")
+	}
+
+	project, err := ProjectItems(client, o, number, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	return &projects[answerIndex], nil
+	return SelectItem(project.Items.Nodes)
+}
+
+// SelectItem prompts the user to select one of items. The list can be searched by typing.
+func SelectItem(items []ProjectItem) (*ProjectItem, error) {
+	if len(items) == 0 {
+		return nil, errors.New("no items found")
+	}
+
+	options := make([]string, 0, len(items))
+	for _, i := range items {
+		options = append(options, ItemOption(i))
+	}
+
+	answerIndex, err := Select("Which item would you like to use?", options, "use --id to select the item")
+	if err != nil {
+		return nil, err
+	}
+
+	return &items[answerIndex], nil
+}
+
+// ItemOption describes an item in a prompt, such as "Fix bug (Issue cli/cli#12)".
+func ItemOption(i ProjectItem) string {
+	if i.Number() == 0 {
+		return fmt.Sprintf("%s (%s)", i.Title(), i.Type())
+	}
+	return fmt.Sprintf("%s (%s %s#%d)", i.Title(), i.Type(), i.Repo(), i.Number())
 }
 
 // Projects returns all the projects for an Owner. If the OwnerType is VIEWER, no login is required.
@@ -1141,3 +1207,380 @@ func Projects(client *api.GraphQLClient, login string, t OwnerType, limit int, f
 		variables["after"] = cursor
 	}
 }
+
+// projectLinks is used to query the repositories and teams linked to a project.
+type projectLinks struct {
+	Node struct {
+		Project struct {
+			Repositories struct {
+				Nodes []struct {
+					NameWithOwner string
+				}
+			} `graphql:"repositories(first: 100)"`
+			Teams struct {
+				Nodes []struct {
+					CombinedSlug string
+				}
+			} `graphql:"teams(first: 100)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectLinks returns the names of the repositories and the slugs of the teams linked to a project.
+func ProjectLinks(client *api.GraphQLClient, projectID string) ([]string, []string, error) {
+	variables := map[string]interface{}{
+		"id": githubv4.ID(projectID),
+	}
+	var query projectLinks
+	err := doQuery(client, "ProjectLinks", &query, variables)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repos := make([]string, 0, len(query.Node.Project.Repositories.Nodes))
+	for _, r := range query.Node.Project.Repositories.Nodes {
+		repos = append(repos, r.NameWithOwner)
+	}
+	teams := make([]string, 0, len(query.Node.Project.Teams.Nodes))
+	for _, t := range query.Node.Project.Teams.Nodes {
+		teams = append(teams, t.CombinedSlug)
+	}
+	return repos, teams, nil
+}
+
+// Repository is a Repository GraphQL object https://docs.github.com/en/graphql/reference/objects#repository.
+type Repository struct {
+	ID            string
+	NameWithOwner string
+}
+
+// repositoryQuery is used to query a repository by owner and name.
+type repositoryQuery struct {
+	Repository Repository `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// NewRepository looks up a repository from a reference of the form OWNER/REPO.
+// The returned NameWithOwner has the casing used by GitHub, which may differ from the reference.
+func NewRepository(client *api.GraphQLClient, nameWithOwner string) (*Repository, error) {
+	owner, name, ok := strings.Cut(nameWithOwner, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("invalid repository %q, must be of the form OWNER/REPO", nameWithOwner)
+	}
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(name),
+	}
+	var query repositoryQuery
+	err := doQuery(client, "Repository", &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	return &query.Repository, nil
+}
+
+// RepositoryMetadata holds the labels and open milestones of a repository, to look up their IDs by name.
+type RepositoryMetadata struct {
+	Labels struct {
+		Nodes []struct {
+			ID   string
+			Name string
+		}
+	} `graphql:"labels(first: 100)"`
+	Milestones struct {
+		Nodes []struct {
+			ID    string
+			Title string
+		}
+	} `graphql:"milestones(first: 100, states: [OPEN])"`
+}
+
+// repositoryMetadataQuery is used to query the labels and milestones of a repository.
+type repositoryMetadataQuery struct {
+	Repository RepositoryMetadata `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// NewRepositoryMetadata looks up the labels and open milestones of a repository from a reference of the form OWNER/REPO.
+func NewRepositoryMetadata(client *api.GraphQLClient, nameWithOwner string) (*RepositoryMetadata, error) {
+	owner, name, ok := strings.Cut(nameWithOwner, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("invalid repository %q, must be of the form OWNER/REPO", nameWithOwner)
+	}
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(name),
+	}
+	var query repositoryMetadataQuery
+	err := doQuery(client, "RepositoryMetadata", &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	return &query.Repository, nil
+}
+
+// LabelIDs returns the IDs of the labels with the given names, which are compared case-insensitively.
+func (m RepositoryMetadata) LabelIDs(names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, l := range m.Labels.Nodes {
+			if strings.EqualFold(l.Name, name) {
+				ids = append(ids, l.ID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("label %q not found", name)
+		}
+	}
+	return ids, nil
+}
+
+// MilestoneID returns the ID of the open milestone with the given title, which is compared case-insensitively.
+func (m RepositoryMetadata) MilestoneID(title string) (string, error) {
+	for _, ms := range m.Milestones.Nodes {
+		if strings.EqualFold(ms.Title, title) {
+			return ms.ID, nil
+		}
+	}
+	return "", fmt.Errorf("milestone %q not found", title)
+}
+
+// Team is a Team GraphQL object https://docs.github.com/en/graphql/reference/objects#team.
+type Team struct {
+	ID           string
+	CombinedSlug string
+}
+
+// teamQuery is used to query a team of an organization by slug.
+type teamQuery struct {
+	Organization struct {
+		Team *Team `graphql:"team(slug: $slug)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+// NewTeam looks up a team from a reference of the form ORG/SLUG.
+func NewTeam(client *api.GraphQLClient, combinedSlug string) (*Team, error) {
+	org, slug, ok := strings.Cut(combinedSlug, "/")
+	if !ok || org == "" || slug == "" || strings.Contains(slug, "/") {
+		return nil, fmt.Errorf("invalid team %q, must be of the form ORG/SLUG", combinedSlug)
+	}
+
+	variables := map[string]interface{}{
+		"login": githubv4.String(org),
+		"slug":  githubv4.String(slug),
+	}
+	var query teamQuery
+	err := doQuery(client, "Team", &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	if query.Organization.Team == nil {
+		return nil, fmt.Errorf("team %s not found", combinedSlug)
+	}
+	return query.Organization.Team, nil
+}
+
+// ProjectCollaborator is a ProjectV2ActorEdge GraphQL object https://docs.github.com/en/graphql/reference/objects#projectv2actoredge,
+// a user or team collaborator of a project along with its role.
+type ProjectCollaborator struct {
+	Role  string
+	Actor struct {
+		TypeName string `graphql:"__typename"`
+		User     struct {
+			Login string
+		} `graphql:"... on User"`
+		Team struct {
+			CombinedSlug string
+		} `graphql:"... on Team"`
+	} `graphql:"node"`
+}
+
+// Type is the typename of the collaborator, either User or Team.
+func (c ProjectCollaborator) Type() string {
+	return c.Actor.TypeName
+}
+
+// Name is the login of a user collaborator or the ORG/SLUG of a team collaborator.
+func (c ProjectCollaborator) Name() string {
+	if c.Actor.TypeName == "Team" {
+		return c.Actor.Team.CombinedSlug
+	}
+	return c.Actor.User.Login
+}
+
+// projectCollaborators is used to query the collaborators of a project.
+type projectCollaborators struct {
+	Node struct {
+		Project struct {
+			Collaborators struct {
+				TotalCount int
+				PageInfo   PageInfo
+				Edges      []ProjectCollaborator
+			} `graphql:"collaborators(first: 100, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectCollaborators returns all the users and teams that are collaborators of a project, with their roles.
+func ProjectCollaborators(client *api.GraphQLClient, projectID string) ([]ProjectCollaborator, error) {
+	collaborators := make([]ProjectCollaborator, 0)
+	variables := map[string]interface{}{
+		"id":    githubv4.ID(projectID),
+		"after": (*githubv4.String)(nil),
+	}
+
+	for {
+		var query projectCollaborators
+		err := doQuery(client, "ProjectCollaborators", &query, variables)
+		if err != nil {
+			return collaborators, err
+		}
+
+		c := query.Node.Project.Collaborators
+		collaborators = append(collaborators, c.Edges...)
+		if !c.PageInfo.HasNextPage {
+			return collaborators, nil
+		}
+		// set the cursor to the end of the last page
+		cursor := c.PageInfo.EndCursor
+		variables["after"] = &cursor
+	}
+}
+
+// StatusUpdate is a ProjectV2StatusUpdate GraphQL object https://docs.github.com/en/graphql/reference/objects#projectv2statusupdate.
+type StatusUpdate struct {
+	ID         string
+	Body       string
+	Status     string
+	StartDate  string
+	TargetDate string
+	CreatedAt  string
+	Creator    struct {
+		Login string
+	}
+}
+
+// projectStatusUpdates is used to query the status updates of a project.
+type projectStatusUpdates struct {
+	Node struct {
+		Project struct {
+			StatusUpdates struct {
+				TotalCount int
+				Nodes      []StatusUpdate
+			} `graphql:"statusUpdates(first: $first)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectStatusUpdates returns up to limit status updates of a project, along with the total number of status updates.
+func ProjectStatusUpdates(client *api.GraphQLClient, projectID string, limit int) ([]StatusUpdate, int, error) {
+	variables := map[string]interface{}{
+		"id":    githubv4.ID(projectID),
+		"first": githubv4.Int(limit),
+	}
+	var query projectStatusUpdates
+	err := doQuery(client, "ProjectStatusUpdates", &query, variables)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	u := query.Node.Project.StatusUpdates
+	return u.Nodes, u.TotalCount, nil
+}
+
+// ProjectView is a ProjectV2View GraphQL object https://docs.github.com/en/graphql/reference/objects#projectv2view.
+type ProjectView struct {
+	ID     string
+	Name   string
+	Number int
+	Layout string
+	Filter string
+	Fields struct {
+		Nodes []ProjectField
+	} `graphql:"fields(first: 100)"`
+	GroupByFields struct {
+		Nodes []ProjectField
+	} `graphql:"groupByFields(first: 100)"`
+	VerticalGroupByFields struct {
+		Nodes []ProjectField
+	} `graphql:"verticalGroupByFields(first: 100)"`
+	SortByFields struct {
+		Nodes []ProjectViewSortBy
+	} `graphql:"sortByFields(first: 100)"`
+}
+
+// ProjectViewSortBy is a ProjectV2SortByField GraphQL object https://docs.github.com/en/graphql/reference/objects#projectv2sortbyfield.
+type ProjectViewSortBy struct {
+	Direction string
+	Field     ProjectField
+}
+
+// projectViews is used to query the views of a project.
+type projectViews struct {
+	Node struct {
+		Project struct {
+			Views struct {
+				Nodes []ProjectView
+			} `graphql:"views(first: 100)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectViews returns the views of a project, such as its boards, tables and roadmaps.
+func ProjectViews(client *api.GraphQLClient, projectID string) ([]ProjectView, error) {
+	variables := map[string]interface{}{
+		"id": githubv4.ID(projectID),
+	}
+	var query projectViews
+	err := doQuery(client, "ProjectViews", &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	return query.Node.Project.Views.Nodes, nil
+}
+
+// FindProjectView returns the view with the given name, compared case-insensitively, or number.
+func FindProjectView(views []ProjectView, nameOrNumber string) (*ProjectView, error) {
+	number, _ := strconv.Atoi(nameOrNumber)
+	for i, v := range views {
+		if strings.EqualFold(v.Name, nameOrNumber) || (number != 0 && v.Number == number) {
+			return &views[i], nil
+		}
+	}
+	return nil, fmt.Errorf("view %q not found", nameOrNumber)
+}
+
+// ProjectWorkflow is a ProjectV2Workflow GraphQL object https://docs.github.com/en/graphql/reference/objects#projectv2workflow.
+type ProjectWorkflow struct {
+	ID      string
+	Name    string
+	Number  int
+	Enabled bool
+}
+
+// projectWorkflows is used to query the built-in workflows of a project.
+type projectWorkflows struct {
+	Node struct {
+		Project struct {
+			Workflows struct {
+				Nodes []ProjectWorkflow
+			} `graphql:"workflows(first: 100)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectWorkflows returns the built-in workflows of a project, such as "Item closed" or "Auto-add to project".
+func ProjectWorkflows(client *api.GraphQLClient, projectID string) ([]ProjectWorkflow, error) {
+	variables := map[string]interface{}{
+		"id": githubv4.ID(projectID),
+	}
+	var query projectWorkflows
+	err := doQuery(client, "ProjectWorkflows", &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	return query.Node.Project.Workflows.Nodes, nil
+}
@@ -1,9 +1,11 @@
 package queries
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
 )
@@ -326,3 +328,250 @@ func TestProjectFields_NoLimit(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, project.Fields.Nodes, 3)
 }
+
+func TestNewRepository_InvalidReference(t *testing.T) {
+	for _, ref := range []string{"cli", "/go-gh", "cli/", "cli/go-gh/issues"} {
+		_, err := NewRepository(nil, ref)
+		assert.EqualError(t, err, fmt.Sprintf("invalid repository %q, must be of the form OWNER/REPO", ref))
+	}
+}
+
+func TestNewTeam_NotFound(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query Team.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+				"slug":  "missing",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"team": nil,
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	_, err = NewTeam(client, "github/missing")
+	assert.EqualError(t, err, "team github/missing not found")
+}
+
+func TestItemOption(t *testing.T) {
+	var issue ProjectItem
+	issue.Content.TypeName = "Issue"
+	issue.Content.Issue.Title = "a title"
+	issue.Content.Issue.Number = 12
+	issue.Content.Issue.Repository.NameWithOwner = "cli/go-gh"
+	assert.Equal(t, "a title (Issue cli/go-gh#12)", ItemOption(issue))
+
+	var draft ProjectItem
+	draft.Content.TypeName = "DraftIssue"
+	draft.Content.DraftIssue.Title = "a draft"
+	assert.Equal(t, "a draft (DraftIssue)", ItemOption(draft))
+}
+
+func TestSelectItem_NoItems(t *testing.T) {
+	_, err := SelectItem(nil)
+	assert.EqualError(t, err, "no items found")
+}
+
+type stubPrompter struct {
+	messages []string
+	index    int
+}
+
+func (p *stubPrompter) Select(message string, options []string) (int, error) {
+	p.messages = append(p.messages, message)
+	return p.index, nil
+}
+
+func (p *stubPrompter) Input(message string, validate func(string) error) (string, error) {
+	p.messages = append(p.messages, message)
+	return "", nil
+}
+
+func (p *stubPrompter) Confirm(message string) (bool, error) {
+	p.messages = append(p.messages, message)
+	return true, nil
+}
+
+func TestNewOwner_Prompt(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerLoginAndOrgs.*",
+			"variables": map[string]interface{}{
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"login": "monalisa",
+					"id":    "user ID",
+					"organizations": map[string]interface{}{
+						"nodes": []interface{}{
+							map[string]interface{}{
+								"login":                   "github",
+								"viewerCanCreateProjects": true,
+								"id":                      "org ID",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	p := &stubPrompter{index: 1}
+	SetPrompter(p)
+	defer SetPrompter(nil)
+
+	owner, err := NewOwner(client, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, &Owner{Login: "github", Type: OrgOwner, ID: "org ID"}, owner)
+	assert.Equal(t, []string{"Which owner would you like to use?"}, p.messages)
+}
+
+func TestNewOwner_PromptDisabled(t *testing.T) {
+	t.Setenv("GH_PROMPT_DISABLED", "1")
+	SetPrompter(&stubPrompter{})
+	defer SetPrompter(nil)
+
+	client, err := api.NewGraphQLClient(api.ClientOptions{AuthToken: "token"})
+	assert.NoError(t, err)
+
+	_, err = NewOwner(client, "", "")
+	assert.EqualError(t, err, "cannot prompt in non-interactive mode, use --user or --org to select the owner")
+
+	_, err = NewProject(client, &Owner{Login: "github", Type: OrgOwner}, 0, false)
+	assert.EqualError(t, err, "cannot prompt in non-interactive mode, pass the project number as an argument")
+
+	_, err = NewItem(client, &Owner{Login: "github", Type: OrgOwner}, 1)
+	assert.EqualError(t, err, "cannot prompt in non-interactive mode, use --id to select the item")
+}
+
+func TestBulkItemMutationArgs(t *testing.T) {
+	query, variables := bulkItemMutationArgs("ArchiveProjectItems", "archiveProjectV2Item", "project ID", []string{"item 1", "item 2"})
+	assert.Equal(t, "mutation ArchiveProjectItems($projectId: ID!, $item0: ID!, $item1: ID!) { "+
+		"item0: archiveProjectV2Item(input: {projectId: $projectId, itemId: $item0}) { clientMutationId } "+
+		"item1: archiveProjectV2Item(input: {projectId: $projectId, itemId: $item1}) { clientMutationId } }", query)
+	assert.Equal(t, map[string]interface{}{
+		"projectId": "project ID",
+		"item0":     "item 1",
+		"item1":     "item 2",
+	}, variables)
+}
+
+func TestParseFieldValue(t *testing.T) {
+	var estimate, due, labels, status, iteration ProjectFieldWithDetails
+	estimate.TypeName = "ProjectV2Field"
+	estimate.Field.ID = "estimate ID"
+	estimate.Field.Name = "Estimate"
+	estimate.Field.DataType = "NUMBER"
+	due.TypeName = "ProjectV2Field"
+	due.Field.Name = "Due"
+	due.Field.DataType = "DATE"
+	labels.TypeName = "ProjectV2Field"
+	labels.Field.Name = "Labels"
+	labels.Field.DataType = "LABELS"
+	status.TypeName = "ProjectV2SingleSelectField"
+	status.SingleSelectField.ID = "status ID"
+	status.SingleSelectField.Name = "Status"
+	status.SingleSelectField.DataType = "SINGLE_SELECT"
+	status.SingleSelectField.Options = []SingleSelectFieldOptions{{ID: "1", Name: "Todo"}, {ID: "2", Name: "Done"}}
+	iteration.TypeName = "ProjectV2IterationField"
+	iteration.IterationField.ID = "iteration ID"
+	iteration.IterationField.Name = "Iteration"
+	iteration.IterationField.DataType = "ITERATION"
+	iteration.IterationFieldDetails.Configuration.Iterations = []IterationFieldIteration{{ID: "3", Title: "Iteration 2"}}
+	iteration.IterationFieldDetails.Configuration.CompletedIterations = []IterationFieldIteration{{ID: "1", Title: "Iteration 1"}}
+	fields := []ProjectFieldWithDetails{estimate, due, labels, status, iteration}
+
+	input, err := ParseFieldValue("status=done", fields)
+	assert.NoError(t, err)
+	assert.Equal(t, "status ID", input.Field.ID())
+	assert.Equal(t, "Done", input.Text)
+	assert.Equal(t, githubv4.NewString("2"), input.Value.SingleSelectOptionID)
+
+	input, err = ParseFieldValue("iteration=iteration 1", fields)
+	assert.NoError(t, err)
+	assert.Equal(t, "iteration ID", input.Field.ID())
+	assert.Equal(t, "Iteration 1", input.Text)
+	assert.Equal(t, githubv4.NewString("1"), input.Value.IterationID)
+
+	input, err = ParseFieldValue("Estimate=2.5", fields)
+	assert.NoError(t, err)
+	assert.Equal(t, githubv4.NewFloat(2.5), input.Value.Number)
+
+	_, err = ParseFieldValue("Estimate", fields)
+	assert.EqualError(t, err, `invalid field value "Estimate", must be of the form FIELD=VALUE`)
+
+	_, err = ParseFieldValue("Estimate=many", fields)
+	assert.EqualError(t, err, `invalid field value "Estimate=many", Estimate must be a number`)
+
+	_, err = ParseFieldValue("Due=tomorrow", fields)
+	assert.EqualError(t, err, `invalid field value "Due=tomorrow", Due must be a date of the form YYYY-MM-DD`)
+
+	_, err = ParseFieldValue("Status=Later", fields)
+	assert.EqualError(t, err, `invalid field value "Status=Later", Status must be one of Todo, Done`)
+
+	_, err = ParseFieldValue("Iteration=Iteration 3", fields)
+	assert.EqualError(t, err, `invalid field value "Iteration=Iteration 3", Iteration must be one of Iteration 2, Iteration 1`)
+
+	_, err = ParseFieldValue("Labels=bug", fields)
+	assert.EqualError(t, err, `invalid field value "Labels=bug", labels fields cannot be set`)
+
+	_, err = ParseFieldValue("Priority=1", fields)
+	assert.EqualError(t, err, `invalid field value "Priority=1", no field named "Priority"`)
+}
+
+func TestParseItemReference(t *testing.T) {
+	currentRepo := func() (string, string, error) {
+		return "cli", "cli", nil
+	}
+
+	tests := []struct {
+		ref  string
+		want ItemReference
+	}{
+		{ref: "https://github.com/CLI/Go-GH/issues/1", want: ItemReference{Owner: "CLI", Repo: "Go-GH", Number: 1}},
+		{ref: "https://github.com/cli/go-gh/pull/2", want: ItemReference{Owner: "cli", Repo: "go-gh", Number: 2}},
+		{ref: "cli/go-gh#3", want: ItemReference{Owner: "cli", Repo: "go-gh", Number: 3}},
+		{ref: "#4", want: ItemReference{Owner: "cli", Repo: "cli", Number: 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			ref, err := ParseItemReference(tt.ref, currentRepo)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ref)
+		})
+	}
+
+	for _, ref := range []string{"go-gh#1", "cli/go-gh", "cli/go-gh#x", "#0", "https://github.com/cli/go-gh/discussions/1"} {
+		_, err := ParseItemReference(ref, currentRepo)
+		assert.EqualError(t, err, fmt.Sprintf("invalid reference %q, must be a URL, OWNER/REPO#NUMBER or #NUMBER", ref))
+	}
+
+	_, err := ParseItemReference("#1", func() (string, string, error) {
+		return "", "", fmt.Errorf("no git remotes")
+	})
+	assert.EqualError(t, err, `could not resolve "#1" against the current repository: no git remotes`)
+}
@@ -0,0 +1,112 @@
+package queries
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// ItemReference refers to an issue or pull request by repository and number, such as cli/go-gh#123.
+type ItemReference struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+func (r ItemReference) String() string {
+	return fmt.Sprintf("%s/%s#%d", r.Owner, r.Repo, r.Number)
+}
+
+// ParseItemReference parses a reference to an issue or pull request, which can be a URL such as
+// https://github.com/OWNER/REPO/issues/NUMBER, OWNER/REPO#NUMBER, or #NUMBER. The repository of
+// #NUMBER is returned by currentRepo, which is only called for that form.
+func ParseItemReference(ref string, currentRepo func() (string, string, error)) (ItemReference, error) {
+	invalid := fmt.Errorf("invalid reference %q, must be a URL, OWNER/REPO#NUMBER or #NUMBER", ref)
+
+	if strings.HasPrefix(ref, "https://") || strings.HasPrefix(ref, "http://") {
+		u, err := url.Parse(ref)
+		if err != nil {
+			return ItemReference{}, invalid
+		}
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) != 4 || (parts[2] != "issues" && parts[2] != "pull") {
+			return ItemReference{}, invalid
+		}
+		number, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return ItemReference{}, invalid
+		}
+		return ItemReference{Owner: parts[0], Repo: parts[1], Number: number}, nil
+	}
+
+	repo, num, ok := strings.Cut(ref, "#")
+	if !ok {
+		return ItemReference{}, invalid
+	}
+	number, err := strconv.Atoi(num)
+	if err != nil || number <= 0 {
+		return ItemReference{}, invalid
+	}
+
+	if repo == "" {
+		owner, name, err := currentRepo()
+		if err != nil {
+			return ItemReference{}, fmt.Errorf("could not resolve %q against the current repository: %w", ref, err)
+		}
+		return ItemReference{Owner: owner, Repo: name, Number: number}, nil
+	}
+
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return ItemReference{}, invalid
+	}
+	return ItemReference{Owner: owner, Repo: name, Number: number}, nil
+}
+
+// IssueOrPullRequestContent is the ID and URL of an issue or pull request.
+type IssueOrPullRequestContent struct {
+	ID  string
+	URL string
+}
+
+// issueOrPullRequestByNumber is used to query an issue or pull request by repository and number.
+type issueOrPullRequestByNumber struct {
+	Repository *struct {
+		IssueOrPullRequest *struct {
+			Typename    string                    `graphql:"__typename"`
+			Issue       IssueOrPullRequestContent `graphql:"... on Issue"`
+			PullRequest IssueOrPullRequestContent `graphql:"... on PullRequest"`
+		} `graphql:"issueOrPullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// IssueOrPullRequest looks up an issue or pull request by reference. Unlike a lookup by URL, the owner
+// and repository names are matched case-insensitively, and the returned URL has the casing used by GitHub.
+func IssueOrPullRequest(client *api.GraphQLClient, ref ItemReference) (*IssueOrPullRequestContent, error) {
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(ref.Owner),
+		"name":   githubv4.String(ref.Repo),
+		"number": githubv4.Int(ref.Number),
+	}
+	var query issueOrPullRequestByNumber
+	err := doQuery(client, "IssueOrPullRequest", &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	if query.Repository == nil {
+		return nil, fmt.Errorf("repository %s/%s not found", ref.Owner, ref.Repo)
+	}
+
+	content := query.Repository.IssueOrPullRequest
+	if content == nil {
+		return nil, fmt.Errorf("no issue or pull request %s found", ref)
+	}
+	if content.Typename == "PullRequest" {
+		return &content.PullRequest, nil
+	}
+	return &content.Issue, nil
+}
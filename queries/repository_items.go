@@ -0,0 +1,190 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// RepositoryItemsFilter selects the issues and pull requests of a repository.
+type RepositoryItemsFilter struct {
+	// Labels are the names of labels that all items must have.
+	Labels []string
+	// Milestone is the title of the milestone the items must be in.
+	Milestone string
+	// State is one of open, closed or all. Merged pull requests are closed.
+	State string
+}
+
+// RepositoryItem is an issue or pull request of a repository.
+type RepositoryItem struct {
+	TypeName string
+	ID       string
+	Number   int
+	Title    string
+	URL      string
+	State    string
+}
+
+// Reference returns the item as a reference such as cli/go-gh#1.
+func (i RepositoryItem) Reference(nameWithOwner string) string {
+	return fmt.Sprintf("%s#%d", nameWithOwner, i.Number)
+}
+
+type repositoryItemNode struct {
+	ID     string
+	Number int
+	Title  string
+	URL    string
+	State  string
+	Labels struct {
+		Nodes []struct {
+			Name string
+		}
+	} `graphql:"labels(first: 100)"`
+	Milestone *struct {
+		Title string
+	}
+}
+
+func (n repositoryItemNode) matches(filter RepositoryItemsFilter) bool {
+	if filter.Milestone != "" && (n.Milestone == nil || !strings.EqualFold(n.Milestone.Title, filter.Milestone)) {
+		return false
+	}
+	for _, name := range filter.Labels {
+		found := false
+		for _, l := range n.Labels.Nodes {
+			if strings.EqualFold(l.Name, name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// repositoryIssuesQuery is used to query a page of the issues of a repository.
+type repositoryIssuesQuery struct {
+	Repository *struct {
+		Issues struct {
+			PageInfo PageInfo
+			Nodes    []repositoryItemNode
+		} `graphql:"issues(first: $first, after: $after, states: $states, labels: $labels)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// repositoryPullRequestsQuery is used to query a page of the pull requests of a repository.
+type repositoryPullRequestsQuery struct {
+	Repository *struct {
+		PullRequests struct {
+			PageInfo PageInfo
+			Nodes    []repositoryItemNode
+		} `graphql:"pullRequests(first: $first, after: $after, states: $states, labels: $labels)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// RepositoryItems returns the issues and pull requests of a repository, given as OWNER/REPO, that match
+// the filter, issues first. All pages are fetched. Labels are filtered by the API, which matches any of
+// them, and then checked to all be present along with the milestone.
+func RepositoryItems(client *api.GraphQLClient, nameWithOwner string, filter RepositoryItemsFilter) ([]RepositoryItem, error) {
+	owner, name, ok := strings.Cut(nameWithOwner, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("invalid repository %q, must be of the form OWNER/REPO", nameWithOwner)
+	}
+
+	// the filters are pointers to slices so that they are optional arguments, and sent as null when unset
+	var issueStates *[]githubv4.IssueState
+	var pullRequestStates *[]githubv4.PullRequestState
+	switch filter.State {
+	case "open":
+		issueStates = &[]githubv4.IssueState{githubv4.IssueStateOpen}
+		pullRequestStates = &[]githubv4.PullRequestState{githubv4.PullRequestStateOpen}
+	case "closed":
+		issueStates = &[]githubv4.IssueState{githubv4.IssueStateClosed}
+		pullRequestStates = &[]githubv4.PullRequestState{githubv4.PullRequestStateClosed, githubv4.PullRequestStateMerged}
+	case "all", "":
+	default:
+		return nil, fmt.Errorf("state must be one of open, closed or all")
+	}
+
+	var labels *[]githubv4.String
+	if len(filter.Labels) > 0 {
+		names := make([]githubv4.String, 0, len(filter.Labels))
+		for _, l := range filter.Labels {
+			names = append(names, githubv4.String(l))
+		}
+		labels = &names
+	}
+
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"first":  githubv4.Int(LimitMax),
+		"after":  (*githubv4.String)(nil),
+		"labels": labels,
+		"states": issueStates,
+	}
+
+	items := make([]RepositoryItem, 0)
+	for {
+		var query repositoryIssuesQuery
+		err := doQuery(client, "RepositoryIssues", &query, variables)
+		if err != nil {
+			return nil, err
+		}
+		if query.Repository == nil {
+			return nil, fmt.Errorf("repository %s not found", nameWithOwner)
+		}
+		items = appendRepositoryItems(items, "Issue", query.Repository.Issues.Nodes, filter)
+		if !query.Repository.Issues.PageInfo.HasNextPage {
+			break
+		}
+		// set the cursor to the end of the last page
+		cursor := query.Repository.Issues.PageInfo.EndCursor
+		variables["after"] = &cursor
+	}
+
+	variables["after"] = (*githubv4.String)(nil)
+	variables["states"] = pullRequestStates
+	for {
+		var query repositoryPullRequestsQuery
+		err := doQuery(client, "RepositoryPullRequests", &query, variables)
+		if err != nil {
+			return nil, err
+		}
+		if query.Repository == nil {
+			return nil, fmt.Errorf("repository %s not found", nameWithOwner)
+		}
+		items = appendRepositoryItems(items, "PullRequest", query.Repository.PullRequests.Nodes, filter)
+		if !query.Repository.PullRequests.PageInfo.HasNextPage {
+			break
+		}
+		// set the cursor to the end of the last page
+		cursor := query.Repository.PullRequests.PageInfo.EndCursor
+		variables["after"] = &cursor
+	}
+
+	return items, nil
+}
+
+func appendRepositoryItems(items []RepositoryItem, typeName string, nodes []repositoryItemNode, filter RepositoryItemsFilter) []RepositoryItem {
+	for _, n := range nodes {
+		if !n.matches(filter) {
+			continue
+		}
+		items = append(items, RepositoryItem{
+			TypeName: typeName,
+			ID:       n.ID,
+			Number:   n.Number,
+			Title:    n.Title,
+			URL:      n.URL,
+			State:    n.State,
+		})
+	}
+	return items
+}
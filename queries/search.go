@@ -0,0 +1,97 @@
+package queries
+
+import (
+	"fmt"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// SearchLimit is the number of results the search API returns at most for a query.
+const SearchLimit = 1000
+
+// SearchItem is an issue or pull request found by a search.
+type SearchItem struct {
+	TypeName   string
+	ID         string
+	Number     int
+	Title      string
+	URL        string
+	Repository struct {
+		NameWithOwner string
+	}
+}
+
+// Reference returns the item as a reference such as cli/go-gh#1.
+func (i SearchItem) Reference() string {
+	return fmt.Sprintf("%s#%d", i.Repository.NameWithOwner, i.Number)
+}
+
+type searchItemNode struct {
+	ID         string
+	Number     int
+	Title      string
+	URL        string
+	Repository struct {
+		NameWithOwner string
+	}
+}
+
+func (n searchItemNode) item(typeName string) SearchItem {
+	item := SearchItem{
+		TypeName: typeName,
+		ID:       n.ID,
+		Number:   n.Number,
+		Title:    n.Title,
+		URL:      n.URL,
+	}
+	item.Repository.NameWithOwner = n.Repository.NameWithOwner
+	return item
+}
+
+// searchItemsQuery is used to query a page of the issues and pull requests matching a search.
+type searchItemsQuery struct {
+	Search struct {
+		IssueCount int
+		PageInfo   PageInfo
+		Nodes      []struct {
+			TypeName    string         `graphql:"__typename"`
+			Issue       searchItemNode `graphql:"... on Issue"`
+			PullRequest searchItemNode `graphql:"... on PullRequest"`
+		}
+	} `graphql:"search(query: $query, type: ISSUE, first: $first, after: $after)"`
+}
+
+// SearchItems returns the issues and pull requests matching a search query, such as
+// "org:github is:open label:bug", along with the number of matches. The search API
+// returns at most SearchLimit results, so the count can be larger than the items returned.
+func SearchItems(client *api.GraphQLClient, query string) ([]SearchItem, int, error) {
+	variables := map[string]interface{}{
+		"query": githubv4.String(query),
+		"first": githubv4.Int(LimitMax),
+		"after": (*githubv4.String)(nil),
+	}
+
+	items := make([]SearchItem, 0)
+	for {
+		var q searchItemsQuery
+		err := doQuery(client, "SearchItems", &q, variables)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, n := range q.Search.Nodes {
+			switch n.TypeName {
+			case "Issue":
+				items = append(items, n.Issue.item(n.TypeName))
+			case "PullRequest":
+				items = append(items, n.PullRequest.item(n.TypeName))
+			}
+		}
+		if !q.Search.PageInfo.HasNextPage || len(items) >= SearchLimit {
+			return items, q.Search.IssueCount, nil
+		}
+		// set the cursor to the end of the last page
+		cursor := q.Search.PageInfo.EndCursor
+		variables["after"] = &cursor
+	}
+}
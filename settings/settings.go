@@ -0,0 +1,70 @@
+// Package settings stores the preferences of the extension, such as the default columns
+// of item-list for each project, in a YAML file next to the gh configuration.
+package settings
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/cli/go-gh/v2/pkg/config"
+	"gopkg.in/yaml.v3"
+)
+
+// Settings are the stored preferences.
+type Settings struct {
+	// Columns are the item-list columns remembered for each project, by project ID.
+	Columns map[string][]string `yaml:"columns,omitempty"`
+}
+
+// DefaultPath is the path of the settings file in the gh configuration directory.
+func DefaultPath() string {
+	return filepath.Join(config.ConfigDir(), "gh-projects", "settings.yml")
+}
+
+// Load reads the settings at path. A missing file results in empty settings.
+func Load(path string) (*Settings, error) {
+	s := &Settings{}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(b, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Save writes the settings to path, creating its directory if needed.
+func (s *Settings) Save(path string) error {
+	b, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o600)
+}
+
+// ProjectColumns returns the columns remembered for a project, if any.
+func (s *Settings) ProjectColumns(projectID string) []string {
+	return s.Columns[projectID]
+}
+
+// SetProjectColumns remembers the columns of a project. Empty columns forget them.
+func (s *Settings) SetProjectColumns(projectID string, columns []string) {
+	if len(columns) == 0 {
+		delete(s.Columns, projectID)
+		return
+	}
+	if s.Columns == nil {
+		s.Columns = make(map[string][]string)
+	}
+	s.Columns[projectID] = columns
+}
@@ -0,0 +1,35 @@
+package settings
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad_Missing(t *testing.T) {
+	s, err := Load(filepath.Join(t.TempDir(), "settings.yml"))
+	assert.NoError(t, err)
+	assert.Nil(t, s.ProjectColumns("project ID"))
+}
+
+func TestSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gh-projects", "settings.yml")
+
+	s := &Settings{}
+	s.SetProjectColumns("project ID", []string{"Title", "Status"})
+	s.SetProjectColumns("other project ID", []string{"Title"})
+	assert.NoError(t, s.Save(path))
+
+	s, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Title", "Status"}, s.ProjectColumns("project ID"))
+
+	s.SetProjectColumns("project ID", nil)
+	assert.NoError(t, s.Save(path))
+
+	s, err = Load(path)
+	assert.NoError(t, err)
+	assert.Nil(t, s.ProjectColumns("project ID"))
+	assert.Equal(t, []string{"Title"}, s.ProjectColumns("other project ID"))
+}